@@ -9,7 +9,8 @@ import (
 
 // UnicodeBreaker represents a logic to split up
 // Unicode sequences into smaller parts. They are used by Segmenters
-// to supply breaking logic.
+// to supply breaking logic. At the end of text, breakers receive EOT
+// as a final code-point.
 type UnicodeBreaker interface {
 	CodePointClassFor(rune) int
 	StartRulesFor(rune, int)
@@ -18,6 +19,16 @@ type UnicodeBreaker interface {
 	Penalties() []int
 }
 
+// A WordTyper is a UnicodeBreaker which is able to tell the type of a segment,
+// e.g. if it is a word of letters, a number or punctuation. Type values are
+// specific to the breaker, which usually defines constants for them (see
+// uax29.Letter). Segmenters will ask their primary breaker for the type of
+// every code-point read, if it implements WordTyper. The type of a segment
+// is the greatest type of its code-points.
+type WordTyper interface {
+	CodePointType(r rune, cpClass int) int
+}
+
 // NfaStateFn represents a state in a non-deterministic finite automata.
 // Functions of type NfaStateFn try to match a rune (Unicode code-point).
 // The caller may provide a third argument, which should be a rune class.
@@ -57,6 +68,7 @@ type Recognizer struct {
 	UserData  interface{} // clients may need to store additional information
 	penalties []int       // penalties to return, used internally in DoAccept()
 	nextStep  NfaStateFn  // next step of a DFA
+	rule      NfaStateFn  // first step, used to name the rule
 }
 
 // NewRecognizer creates a new Recognizer.
@@ -67,6 +79,7 @@ func NewRecognizer(codePointClass int, next NfaStateFn) *Recognizer {
 	rec := &Recognizer{}
 	rec.Expect = codePointClass
 	rec.nextStep = next
+	rec.rule = next
 	return rec
 }
 
@@ -101,6 +114,7 @@ func NewPooledRecognizer(cpClass int, stateFn NfaStateFn) *Recognizer {
 	rec := o.(*Recognizer)
 	rec.Expect = cpClass
 	rec.nextStep = stateFn
+	rec.rule = stateFn
 	return rec
 }
 
@@ -110,6 +124,7 @@ func (rec *Recognizer) releaseIntoPool() {
 	rec.Expect = 0
 	rec.MatchLen = 0
 	rec.nextStep = nil
+	rec.rule = nil
 	_ = globalRecognizerPool.opool.ReturnObject(globalRecognizerPool.ctx, rec)
 }
 
@@ -205,13 +220,13 @@ type RuneSubscriber interface {
 type RunePublisher interface {
 	SubscribeMe(RuneSubscriber) RunePublisher // subscribe an additional rune subscriber
 	PublishRuneEvent(r rune, codePointClass int) (longestDistance int, penalties []int)
-	//SetPenaltyAggregator(pa PenaltyAggregator) // function to aggregate break penalties
+	SetPenaltyAggregator(pa PenaltyAggregator) // function to aggregate break penalties
+	SetRuleObserver(obs RuleObserver)          // callback to explain the firing of rules
 }
 
 // NewRunePublisher creates a new default RunePublisher.
 func NewRunePublisher() *DefaultRunePublisher {
 	rpub := &DefaultRunePublisher{}
-	//rpub.aggregate = AddPenalties
 	return rpub
 }
 
@@ -234,18 +249,31 @@ func (rpub *DefaultRunePublisher) PublishRuneEvent(r rune, codePointClass int) (
 	//CT.Infof("pre-publish(): total penalites = %v", rpub.penaltiesTotal)
 	rpub.penaltiesTotal = rpub.penaltiesTotal[:0]
 	//CT.Infof("pre-publish(): total penalites = %v", rpub.penaltiesTotal)
+	if rpub.observer != nil {
+		for _, subscr := range rpub.started {
+			rpub.observer(RuleEvent{Rule: ruleName(subscr), Kind: RuleStarted, Rune: r})
+		}
+		rpub.started = rpub.started[:0]
+	}
 	// pre-condition: no subscriber is Done()
 	for i := rpub.Len() - 1; i >= 0; i-- {
 		subscr := rpub.at(i)
 		penalties := subscr.RuneEvent(r, codePointClass)
+		if rpub.observer != nil && subscr.Done() {
+			rpub.explain(subscr, r, penalties)
+		}
 		//CT().Infof("    publish():       penalites = %v", penalties)
 		for j, p := range penalties { // aggregate all penalties
 			if j >= len(rpub.penaltiesTotal) {
 				rpub.penaltiesTotal = append(rpub.penaltiesTotal, p)
-			} else {
-				//rpub.aggregate.Aggregate(p)
-				//rpub.penaltiesTotal[j] = rpub.aggregate(rpub.penaltiesTotal[j], p)
-				rpub.penaltiesTotal[j] += p
+			} else if rpub.penaltiesTotal[j] == 0 {
+				rpub.penaltiesTotal[j] = p
+			} else if p != 0 {
+				if rpub.aggregate == nil { // we allow uninitialized DefaultRunePublishers
+					rpub.penaltiesTotal[j] += p
+				} else {
+					rpub.penaltiesTotal[j] = rpub.aggregate(rpub.penaltiesTotal[j], p)
+				}
 			}
 		}
 		//CT().Infof("    publish(): total penalites = %v", rpub.penaltiesTotal)
@@ -266,86 +294,83 @@ func (rpub *DefaultRunePublisher) PublishRuneEvent(r rune, codePointClass int) (
 
 // --- Penalty aggregators ---------------------------------------------------
 
-// PenaltyAggregator is a type for methods of penalty-aggregation. Aggregates all the
-// break penalties at a break-point to a single penalty value at that point.
-// type PenaltyAggregator interface {
-// 	Aggregate(int)
-// 	HasValue() bool
-// 	Value() int
-// }
+// PenaltyAggregator is a type for functions of penalty-aggregation. A rune publisher
+// folds all the break penalties its subscribers report for a break-point into
+// a single penalty value at that point.
+//
+// A penalty of 0 is neutral, i.e. it is not an opinion about a break-point at all
+// (see the package documentation). Aggregators are therefore called for non-zero
+// penalties only, with p1 being the aggregate so far and p2 the next penalty to
+// fold in.
+type PenaltyAggregator func(p1, p2 int) int
 
 // SetPenaltyAggregator sets a PenaltyAggregator for a rune publisher.
 // A PenaltyAggregator aggregates all the
 // break penalties at a break-point to a single penalty value at that point.
+// Setting nil restores the default, AddPenalties.
 //
 // Part of interface RunePublisher.
-// func (rpub *DefaultRunePublisher) SetPenaltyAggregator(pa PenaltyAggregator) {
-// 	if pa == nil {
-// 		rpub.aggregate = &AddPenalties{}
-// 	} else {
-// 		rpub.aggregate = pa
-// 	}
-// }
+func (rpub *DefaultRunePublisher) SetPenaltyAggregator(pa PenaltyAggregator) {
+	rpub.aggregate = pa
+}
 
 // AddPenalties is the default aggregator for break-penalties.
 // Simply adds up all penalties at each break position, respectively.
-//
-// The zero value is 0, thus AddPenalties calculates a monoid fold
-// of 0+n1=n, n+n2=n, …;  i.e., n1+n2+…
-//
-// type AddPenalties struct {
-// 	sum      int
-// 	modified bool
-// }
-
-// func (a *AddPenalties) Aggregate(n int) {
-// 	a.modified = true
-// 	a.sum += n
-// }
-
-// func (a *AddPenalties) HasValue() bool {
-// 	return a.modified
-// }
-
-// func (a *AddPenalties) Value() int {
-// 	return a.sum
-// }
+func AddPenalties(p1, p2 int) int {
+	return p1 + p2
+}
 
 // MaxPenalties is an alternative function to aggregate break-penalties.
-// Returns maximum of all penalties at each break position.
-//
-// The zero value is InfinitePenalty, thus MaxMerits calculates a semi-group fold
-// of min(∞,n1)=n, min(n,n2)=n, …  (remember, mertits are negative penalties)
-//
+// Returns maximum of all penalties at each break position, i.e., the
+// strongest objection against a break wins.
+func MaxPenalties(p1, p2 int) int {
+	return max(p1, p2)
+}
 
-// type MaxMerits struct {
-// 	m        int
-// 	modified bool
-// }
+// MinPenalties is an alternative function to aggregate break-penalties.
+// Returns minimum of all penalties at each break position, i.e., the
+// strongest vote for a break wins.
+func MinPenalties(p1, p2 int) int {
+	return min(p1, p2)
+}
 
-// func (a *MaxMerits) Aggregate(n int) {
-// 	if !a.modified {
-// 		a.m = InfinitePenalty
-// 	}
-// 	a.modified = true
-// 	a.m = min(a.m, n)
-// }
+// MandatoryDominates is an alternative function to aggregate break-penalties.
+// If a mandatory break (≤ InfiniteMerits) is among the penalties at a break
+// position, it wins over all other penalties, including inhibited breaks.
+// Otherwise penalties are added up.
+func MandatoryDominates(p1, p2 int) int {
+	if p1 <= InfiniteMerits || p2 <= InfiniteMerits {
+		return min(p1, p2)
+	}
+	return p1 + p2
+}
 
-// func (a *MaxMerits) HasValue() bool {
-// 	return a.modified
-// }
+// SetRuleObserver sets a callback to receive events about rules (subscribers)
+// being started, accepting or aborting. Setting nil switches events off.
+//
+// Part of interface RunePublisher.
+func (rpub *DefaultRunePublisher) SetRuleObserver(obs RuleObserver) {
+	rpub.observer = obs
+	rpub.started = rpub.started[:0]
+}
 
-// func (a *MaxMerits) Value() int {
-// 	return a.m
-// }
+// explain sends an event for a subscriber which is done to the rule observer.
+func (rpub *DefaultRunePublisher) explain(subscr RuneSubscriber, r rune, penalties []int) {
+	e := RuleEvent{Rule: ruleName(subscr), Kind: RuleAborted, Rune: r}
+	if e.MatchLen = subscr.MatchLength(); e.MatchLen > 0 {
+		e.Kind = RuleAccepted
+		e.Penalties = penalties
+	}
+	rpub.observer(e)
+}
 
 // SubscribeMe lets a client subscribe to a RunePublisher.
 //
 // Part of interface RunePublisher.
 func (rpub *DefaultRunePublisher) SubscribeMe(rsub RuneSubscriber) RunePublisher {
-	// if rpub.aggregate == nil { // this is necessary as we allow uninitialzed DefaultRunePublishers
-	// 	rpub.aggregate = &AddPenalties{}
-	// }
+	if rpub.observer != nil {
+		rpub.started = append(rpub.started, rsub)
+	}
 	rpub.Push(rsub)
 	return rpub
 }
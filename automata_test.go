@@ -0,0 +1,70 @@
+package uax
+
+import "testing"
+
+// acceptWith returns a rule which accepts a single rune, reporting penalty p
+// for a break before it.
+func acceptWith(p int) NfaStateFn {
+	return func(rec *Recognizer, r rune, cpClass int) NfaStateFn {
+		return DoAccept(rec, 0, p)
+	}
+}
+
+func TestPenaltyAggregators(t *testing.T) {
+	for i, test := range []struct {
+		aggregate PenaltyAggregator
+		penalties []int
+		total     int
+		breakable bool
+	}{
+		{nil, []int{6000, 5000}, 11000, false},
+		{AddPenalties, []int{6000, 5000}, 11000, false},
+		{MaxPenalties, []int{6000, 5000}, 6000, true},
+		{AddPenalties, []int{-500, 9000}, 8500, true},
+		{MaxPenalties, []int{-500, 9000}, 9000, true},
+		{AddPenalties, []int{-5000, 15000}, 10000, false},
+		{MaxPenalties, []int{-5000, 15000}, 15000, false},
+		{MinPenalties, []int{-5000, 15000}, -5000, true},
+		{AddPenalties, []int{InfiniteMerits, 3 * InfinitePenalty}, 2 * InfinitePenalty, false},
+		{MandatoryDominates, []int{InfiniteMerits, 3 * InfinitePenalty}, InfiniteMerits, true},
+		{MandatoryDominates, []int{100, 0, 200}, 300, true},
+	} {
+		rpub := NewRunePublisher()
+		rpub.SetPenaltyAggregator(test.aggregate)
+		for _, p := range test.penalties {
+			rpub.SubscribeMe(NewRecognizer(0, acceptWith(p)))
+		}
+		_, penalties := rpub.PublishRuneEvent('x', 0)
+		if len(penalties) != 2 || penalties[1] != test.total {
+			t.Errorf("test #%d: expected aggregated penalty %d, have %v", i, test.total, penalties)
+			continue
+		}
+		if breakable := penalties[1] < InfinitePenalty; breakable != test.breakable {
+			t.Errorf("test #%d: expected break opportunity to be %v", i, test.breakable)
+		}
+	}
+}
+
+func TestRuleObserver(t *testing.T) {
+	rpub := NewRunePublisher()
+	var events []string
+	rpub.SetRuleObserver(func(e RuleEvent) {
+		events = append(events, e.Kind.String())
+	})
+	rpub.SubscribeMe(NewRecognizer(0, acceptWith(100)))
+	rpub.SubscribeMe(NewRecognizer(0, func(rec *Recognizer, r rune, cpClass int) NfaStateFn {
+		return DoAbort(rec)
+	}))
+	rpub.PublishRuneEvent('x', 0)
+	if len(events) != 4 || events[0] != "started" || events[1] != "started" ||
+		events[2] != "aborted" || events[3] != "accepted" {
+		t.Errorf("unexpected rule events: %v", events)
+	}
+	if name := NewRecognizer(0, rule_test).RuleName(); name != "test" {
+		t.Errorf("expected rule name 'test', have %q", name)
+	}
+}
+
+func rule_test(rec *Recognizer, r rune, cpClass int) NfaStateFn {
+	return DoAbort(rec)
+}
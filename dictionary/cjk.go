@@ -0,0 +1,213 @@
+package dictionary
+
+import (
+	"math"
+	"unicode"
+
+	"github.com/npillmayer/uax"
+)
+
+// === Frequency Weighted Segmentation ===========================
+
+// SegmentByFrequency splits a run of text into words, selecting the most probable
+// segmentation under a unigram model: every word of the word list has a probability
+// of its frequency divided by the sum of all frequencies, and a rune not covered
+// by any word counts as a word of frequency 1. For word lists without
+// frequencies this selects the segmentation with the fewest words.
+//
+// This is the usual approach for Chinese and Japanese, where runs of ideographs
+// are often ambiguous. For example, “研究生命起源” (studying the origin of life)
+// might be segmented into “研究生|命|起源” (graduate student, life, origin), but
+// with realistic frequencies, “研究|生命|起源” is the more probable segmentation.
+//
+// Unlike Segment, uncovered runes are not joined, as runs of ideographs not
+// covered by any word are better treated as single-ideograph words.
+//
+// SegmentByFrequency returns the boundaries between segments as rune positions,
+// i.e. a boundary b means that a segment ends with run[b-1]. The start and the
+// end of the run are not included.
+func (wl *WordList) SegmentByFrequency(run []rune) []int {
+	if wl == nil || len(run) == 0 || len(wl.nodes) == 0 {
+		return nil
+	}
+	logTotal := math.Log(float64(max(wl.total, 1)))
+	type step struct {
+		logp float64 // log probability of best segmentation up to here
+		from int     // start of the segment ending here, -1 if unreachable
+	}
+	steps := make([]step, len(run)+1)
+	for i := range steps {
+		steps[i].from = -1
+	}
+	steps[0].from = 0
+	relax := func(i, j int, freq int32) {
+		logp := steps[i].logp + math.Log(float64(freq)) - logTotal
+		if s := &steps[j]; s.from < 0 || logp > s.logp {
+			s.logp, s.from = logp, i
+		}
+	}
+	for i := 0; i < len(run); i++ {
+		if steps[i].from < 0 {
+			continue
+		}
+		var n int32
+		single := false // is run[i] followed by marks a word?
+		j := i + 1
+		for j < len(run) && isMark(run[j]) {
+			j++
+		}
+		for k := i; k < len(run); k++ {
+			if n = wl.child(n, run[k]); n < 0 {
+				break
+			}
+			if freq := wl.nodes[n].freq; freq > 0 && (k+1 == len(run) || !isMark(run[k+1])) {
+				relax(i, k+1, freq)
+				single = single || k+1 == j
+			}
+		}
+		if !single {
+			relax(i, j, 1)
+		}
+	}
+	var boundaries []int
+	for j := steps[len(run)].from; j > 0; j = steps[j].from {
+		boundaries = append(boundaries, j)
+	}
+	for i, j := 0, len(boundaries)-1; i < j; i, j = i+1, j-1 {
+		boundaries[i], boundaries[j] = boundaries[j], boundaries[i]
+	}
+	tracer().Debugf("dictionary: %q has word boundaries %v", string(run), boundaries)
+	return boundaries
+}
+
+// === CJK Breaker ===============================================
+
+// CJKBreaker is a secondary breaker which refines UAX#29 word breaking for Chinese
+// and Japanese. It implements the uax.UnicodeBreaker interface and is intended to be
+// used next to a uax29.WordBreaker:
+//
+//   words, _ := dictionary.LoadWordListFile("zh-words.txt")
+//   segmenter := segment.NewSegmenter(uax29.NewWordBreaker(1), dictionary.NewCJKBreaker(words))
+//   segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+//
+// UAX#29 breaks between every ideograph and every Hiragana character, and keeps runs
+// of Katakana together. The CJKBreaker segments runs of Han, Hiragana and Katakana
+// characters by frequency (see WordList.SegmentByFrequency). It suppresses breaks
+// within words and puts a penalty for breaking between words, as given by its
+// penalty profile. The segmenter will honour the suppression of breaks only with
+// boundary policy segment.SecondaryVeto.
+//
+// Runs longer than 256 characters are split and segmented in parts.
+type CJKBreaker struct {
+	words     *WordList
+	profile   PenaltyProfile
+	run       []rune // current run of CJK characters
+	penalties []int  // returned to the segmenter: penalties to insert
+}
+
+// Default penalties of the CJK breaker (break between words, suppress break
+// within words).
+const (
+	PenaltyForBreak        = 50
+	PenaltyToSuppressBreak = uax.InfinitePenalty
+)
+
+// PenaltyProfile is a set of penalties for a CJKBreaker to emit. A profile is
+// handed to a breaker at construction time, thus breakers with different
+// profiles may be used side by side.
+type PenaltyProfile struct {
+	ForBreak        int // break between words
+	ToSuppressBreak int // suppress a break within a word
+}
+
+// DefaultPenalties returns the penalty profile breakers use if clients do not
+// provide one.
+func DefaultPenalties() PenaltyProfile {
+	return PenaltyProfile{
+		ForBreak:        PenaltyForBreak,
+		ToSuppressBreak: PenaltyToSuppressBreak,
+	}
+}
+
+// maxCJKRun is the maximum length of a run of CJK characters to segment at once.
+const maxCJKRun = 256
+
+// Code-point classes of the CJK breaker.
+const (
+	otherClass int = iota
+	cjkClass
+)
+
+// NewCJKBreaker creates a CJK breaker for a word list. Clients may provide a
+// penalty profile to replace the default penalties (see DefaultPenalties).
+func NewCJKBreaker(words *WordList, profile ...PenaltyProfile) *CJKBreaker {
+	cb := &CJKBreaker{words: words, profile: DefaultPenalties()}
+	if len(profile) > 0 {
+		cb.profile = profile[0]
+	}
+	return cb
+}
+
+// CodePointClassFor returns a class for a rune (= code-point): 1 for Han,
+// Hiragana and Katakana characters and the prolonged sound mark, 0 otherwise.
+// (Interface uax.UnicodeBreaker)
+func (cb *CJKBreaker) CodePointClassFor(r rune) int {
+	if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー' || r == 'ｰ' {
+		return cjkClass
+	}
+	return otherClass
+}
+
+// StartRulesFor is part of interface uax.UnicodeBreaker.
+// The CJK breaker does not use recognizers, thus this is a no-op.
+func (cb *CJKBreaker) StartRulesFor(r rune, cpClass int) {
+}
+
+// ProceedWithRune is a signal:
+// A new code-point has been read and this breaker receives a message to
+// consume it.
+// (Interface uax.UnicodeBreaker)
+func (cb *CJKBreaker) ProceedWithRune(r rune, cpClass int) {
+	cb.penalties = cb.penalties[:0]
+	if r != uax.EOT && (cpClass == cjkClass || len(cb.run) > 0 && isMark(r)) {
+		cb.run = append(cb.run, r)
+		if len(cb.run) == maxCJKRun {
+			cb.segment(0)
+		}
+		return
+	}
+	if len(cb.run) > 0 {
+		cb.segment(1)
+	}
+}
+
+// segment puts penalties between the runes of the current run. offset is the
+// number of runes read after the run.
+func (cb *CJKBreaker) segment(offset int) {
+	l := len(cb.run)
+	for len(cb.penalties) < l+offset {
+		cb.penalties = append(cb.penalties, 0)
+	}
+	boundaries := cb.words.SegmentByFrequency(cb.run)
+	for i := 0; i < l-1; i++ { // position after run[i] has index l-1-i+offset
+		p := cb.profile.ToSuppressBreak
+		if len(boundaries) > 0 && boundaries[0] == i+1 {
+			p = cb.profile.ForBreak
+			boundaries = boundaries[1:]
+		}
+		cb.penalties[l-1-i+offset] = p
+	}
+	cb.run = cb.run[:0]
+}
+
+// LongestActiveMatch returns the length of the run of CJK characters currently
+// being read.
+// (Interface uax.UnicodeBreaker)
+func (cb *CJKBreaker) LongestActiveMatch() int {
+	return len(cb.run)
+}
+
+// Penalties is part of interface uax.UnicodeBreaker.
+func (cb *CJKBreaker) Penalties() []int {
+	return cb.penalties
+}
@@ -0,0 +1,329 @@
+/*
+Package dictionary implements dictionary based word segmentation for scripts
+written without spaces between words, like Thai, Chinese and Japanese.
+
+Content
+
+Thai, Lao, Khmer and Myanmar are written without spaces between words. UAX#14
+assigns class SA (“complex context dependent”) to these scripts and leaves it to
+tailorings to find line break opportunities within runs of SA characters. UAX#29
+does the same for word boundaries. In the absence of a tailoring, UAX#14 will
+not break SA runs at all, and UAX#29 will break between every character.
+
+Package dictionary provides word lists and a segmentation algorithm based on
+them: maximal matching splits a run of text into the smallest number of words
+found in a word list, minimizing the number of characters not covered by any
+word. Word lists are usually loaded from a file with one word per line:
+
+  # Thai
+  ภาษา
+  ไทย
+
+Typical Usage
+
+Clients load a word list and hand it to the line breaker or the word
+breaker, which will use it for runs of SA characters:
+
+  words, err := dictionary.LoadWordListFile("thai-words.txt")
+  ...
+  linewrap := uax14.NewLineWrap()
+  linewrap.SetDictionary(words)
+
+  onWords := uax29.NewWordBreaker(1)
+  onWords.SetDictionary(words)
+
+Chinese and Japanese
+
+UAX#29 treats every ideograph as a word of its own. For Chinese and Japanese, word
+lists usually come with frequencies, and the most probable segmentation is
+selected (see WordList.SegmentByFrequency). A CJKBreaker refines UAX#29 word
+breaking within runs of Han, Hiragana and Katakana characters:
+
+  words, err := dictionary.LoadWordListFile("zh-words.txt")
+  ...
+  segmenter := segment.NewSegmenter(uax29.NewWordBreaker(1), dictionary.NewCJKBreaker(words))
+  segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+
+______________________________________________________________________
+
+License
+
+This project is provided under the terms of the UNLICENSE or
+the 3-Clause BSD license denoted by the following SPDX identifier:
+
+SPDX-License-Identifier: 'Unlicense' OR 'BSD-3-Clause'
+
+You may use the project under the terms of either license.
+
+Licenses are reproduced in the license file in the root folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+*/
+package dictionary
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/npillmayer/schuko/tracing"
+)
+
+// tracer traces to uax.segment .
+func tracer() tracing.Trace {
+	return tracing.Select("uax.segment")
+}
+
+// === Word Lists ================================================
+
+// WordList is a set of words, optionally with frequencies. Words are stored as
+// a trie, with the nodes in a single slice and the edges of every node sorted by
+// rune, which keeps large word lists compact.
+type WordList struct {
+	nodes []trieNode // nodes[0] is the root
+	size  int        // number of words
+	total int64      // sum of all frequencies
+}
+
+type trieNode struct {
+	edges []trieEdge // sorted by rune
+	freq  int32      // frequency of the word ending at this node, 0 if none
+}
+
+type trieEdge struct {
+	r  rune
+	to int32 // index of child node
+}
+
+// NewWordList creates a word list from a list of words. Every word has
+// frequency 1.
+func NewWordList(words ...string) *WordList {
+	wl := &WordList{nodes: make([]trieNode, 1)}
+	wl.Add(words...)
+	return wl
+}
+
+// Add adds words to a word list. Words not yet contained in the word list get
+// frequency 1.
+func (wl *WordList) Add(words ...string) {
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w == "" {
+			continue
+		}
+		if n := wl.insert(w); wl.nodes[n].freq == 0 {
+			wl.nodes[n].freq = 1
+			wl.size++
+			wl.total++
+		}
+	}
+}
+
+// AddWithFrequency adds a word to a word list, or sets its frequency if it is
+// already contained. Frequencies are counts of occurrences in a corpus and are
+// capped at 2^31-1. A frequency of less than 1 is set to 1.
+func (wl *WordList) AddWithFrequency(word string, freq int) {
+	if word = strings.TrimSpace(word); word == "" {
+		return
+	}
+	freq = min(max(freq, 1), math.MaxInt32)
+	n := wl.insert(word)
+	if wl.nodes[n].freq == 0 {
+		wl.size++
+	}
+	wl.total += int64(freq) - int64(wl.nodes[n].freq)
+	wl.nodes[n].freq = int32(freq)
+}
+
+// insert enters the runes of a word into the trie and returns the index of the
+// node for the last rune.
+func (wl *WordList) insert(word string) int32 {
+	if len(wl.nodes) == 0 {
+		wl.nodes = make([]trieNode, 1)
+	}
+	var n int32
+	for _, r := range word {
+		edges := wl.nodes[n].edges
+		i := sort.Search(len(edges), func(i int) bool { return edges[i].r >= r })
+		if i < len(edges) && edges[i].r == r {
+			n = edges[i].to
+			continue
+		}
+		child := int32(len(wl.nodes))
+		wl.nodes = append(wl.nodes, trieNode{})
+		edges = append(edges, trieEdge{})
+		copy(edges[i+1:], edges[i:])
+		edges[i] = trieEdge{r: r, to: child}
+		wl.nodes[n].edges = edges
+		n = child
+	}
+	return n
+}
+
+// child returns the index of the child node of n for rune r, or -1.
+func (wl *WordList) child(n int32, r rune) int32 {
+	edges := wl.nodes[n].edges
+	i := sort.Search(len(edges), func(i int) bool { return edges[i].r >= r })
+	if i < len(edges) && edges[i].r == r {
+		return edges[i].to
+	}
+	return -1
+}
+
+// lookup returns the trie node for word, or -1.
+func (wl *WordList) lookup(word string) int32 {
+	if wl == nil || len(wl.nodes) == 0 {
+		return -1
+	}
+	var n int32
+	for _, r := range word {
+		if n = wl.child(n, r); n < 0 {
+			return -1
+		}
+	}
+	return n
+}
+
+// Contains checks if word is contained in the word list.
+func (wl *WordList) Contains(word string) bool {
+	n := wl.lookup(word)
+	return n >= 0 && wl.nodes[n].freq > 0
+}
+
+// Frequency returns the frequency of a word, or 0 if the word is not contained
+// in the word list.
+func (wl *WordList) Frequency(word string) int {
+	if n := wl.lookup(word); n >= 0 {
+		return int(wl.nodes[n].freq)
+	}
+	return 0
+}
+
+// Len returns the number of words in the word list.
+func (wl *WordList) Len() int {
+	return wl.size
+}
+
+// LoadWordList reads a word list from a simple text format:
+// one word per line, empty lines and lines starting with '#' are
+// ignored. A word may be followed by whitespace and its frequency.
+// Any further fields of a line are ignored, which allows to read
+// the dictionaries of some popular CJK segmenters, which add a
+// part-of-speech tag:
+//
+//   # word frequency tag
+//   中国 78000 ns
+//   人民 40000 n
+//
+// Words without a frequency get frequency 1.
+func LoadWordList(r io.Reader) (*WordList, error) {
+	wl := NewWordList()
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 1 {
+			wl.Add(fields[0])
+			continue
+		}
+		freq, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid frequency for word in line %d: %q", lineno, line)
+		}
+		wl.AddWithFrequency(fields[0], freq)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return wl, nil
+}
+
+// LoadWordListFile reads a word list from a text file.
+// See LoadWordList for the format.
+func LoadWordListFile(filename string) (*WordList, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadWordList(f)
+}
+
+// === Maximal Matching ==========================================
+
+// Segment splits a run of text into words, using maximal matching: of all the
+// ways to split the run into words of the word list, the one with the fewest
+// words is selected. Parts of the run not covered by any word of the word list
+// are kept together as single segments, and segmentations with the fewest
+// uncovered runes are preferred over those with fewer words. A combining mark
+// will never start a segment.
+//
+// Segment returns the boundaries between segments as rune positions, i.e. a
+// boundary b means that a segment ends with run[b-1]. The start and the end of
+// the run are not included.
+func (wl *WordList) Segment(run []rune) []int {
+	if wl == nil || len(run) == 0 || len(wl.nodes) == 0 {
+		return nil
+	}
+	type step struct {
+		unknown, words int  // costs to get here
+		from           int  // start of the segment ending here, -1 if unreachable
+		isUnknown      bool // segment is not a word of the word list
+	}
+	steps := make([]step, len(run)+1)
+	for i := range steps {
+		steps[i].from = -1
+	}
+	steps[0].from = 0
+	relax := func(i, j int, unknown int) {
+		u, w := steps[i].unknown+unknown, steps[i].words+1
+		if s := &steps[j]; s.from < 0 || u < s.unknown || u == s.unknown && w < s.words {
+			s.unknown, s.words, s.from, s.isUnknown = u, w, i, unknown > 0
+		}
+	}
+	for i := 0; i < len(run); i++ {
+		if steps[i].from < 0 {
+			continue
+		}
+		var n int32
+		for j := i; j < len(run); j++ {
+			if n = wl.child(n, run[j]); n < 0 {
+				break
+			}
+			if wl.nodes[n].freq > 0 && (j+1 == len(run) || !isMark(run[j+1])) {
+				relax(i, j+1, 0)
+			}
+		}
+		j := i + 1
+		for j < len(run) && isMark(run[j]) {
+			j++
+		}
+		relax(i, j, j-i)
+	}
+	var boundaries []int
+	for j := len(run); j > 0; j = steps[j].from {
+		i := steps[j].from
+		if i > 0 && !(steps[j].isUnknown && steps[i].isUnknown) { // join uncovered parts
+			boundaries = append(boundaries, i)
+		}
+	}
+	for i, j := 0, len(boundaries)-1; i < j; i, j = i+1, j-1 {
+		boundaries[i], boundaries[j] = boundaries[j], boundaries[i]
+	}
+	tracer().Debugf("dictionary: %q has word boundaries %v", string(run), boundaries)
+	return boundaries
+}
+
+func isMark(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Mc)
+}
@@ -0,0 +1,231 @@
+package dictionary_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/dictionary"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func ExampleWordList_Segment() {
+	words := dictionary.NewWordList("ฉัน", "รัก", "ภาษา", "ไทย")
+	run := []rune("ฉันรักภาษาไทย")
+	start := 0
+	for _, b := range append(words.Segment(run), len(run)) {
+		fmt.Println(string(run[start:b]))
+		start = b
+	}
+	// Output: ฉัน
+	// รัก
+	// ภาษา
+	// ไทย
+}
+
+func TestLoadWordList(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	words, err := dictionary.LoadWordListFile("testdata/thai-words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words.Len() != 13 {
+		t.Errorf("expected 13 words, have %d", words.Len())
+	}
+	for _, w := range []string{"ภาษา", "โรงเรียน", "โรง"} {
+		if !words.Contains(w) {
+			t.Errorf("expected word %q to be loaded", w)
+		}
+	}
+	if words.Contains("โรงเรี") {
+		t.Errorf("did not expect a prefix of a word to be contained")
+	}
+	_, err = dictionary.LoadWordList(strings.NewReader("ภาษา ไทย\n"))
+	if err == nil {
+		t.Errorf("expected error for invalid frequency")
+	}
+}
+
+func TestSegment(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	words, err := dictionary.LoadWordListFile("testdata/thai-words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range []struct {
+		run, words string
+	}{
+		{"ฉันรักภาษาไทยมาก", "ฉัน|รัก|ภาษา|ไทย|มาก"},
+		{"ฉันไปโรงเรียน", "ฉัน|ไป|โรงเรียน"}, // fewest words
+		{"ตากลม", "ตา|กลม"},
+		{"ฉันสวัสดีไทย", "ฉัน|สวัสดี|ไทย"}, // unknown word kept together
+		{"ฉันั", "ฉันั"},                   // do not break before a combining mark
+		{"ไทย", "ไทย"},
+		{"", ""},
+	} {
+		run := []rune(test.run)
+		var segments []string
+		start := 0
+		for _, b := range append(words.Segment(run), len(run)) {
+			segments = append(segments, string(run[start:b]))
+			start = b
+		}
+		if s := strings.Join(segments, "|"); s != test.words {
+			t.Errorf("test #%d: expected %q, have %q", i, test.words, s)
+		}
+	}
+}
+
+func ExampleCJKBreaker() {
+	words, _ := dictionary.LoadWordListFile("testdata/cjk-words.txt")
+	segmenter := segment.NewSegmenter(uax29.NewWordBreaker(1), dictionary.NewCJKBreaker(words))
+	segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+	segmenter.InitFromString("東京都に住んでいます。")
+	for segmenter.Next() {
+		fmt.Println(segmenter.Text())
+	}
+	// Output: 東京都
+	// に
+	// 住んで
+	// います
+	// 。
+}
+
+func TestWordListFrequencies(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	words, err := dictionary.LoadWordListFile("testdata/cjk-words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words.Len() != 18 {
+		t.Errorf("expected 18 words, have %d", words.Len())
+	}
+	if f := words.Frequency("研究生"); f != 1500 {
+		t.Errorf("expected frequency of 1500, have %d", f)
+	}
+	if f := words.Frequency("研"); f != 0 {
+		t.Errorf("expected frequency of 0 for a prefix, have %d", f)
+	}
+	words.Add("研究")
+	if f := words.Frequency("研究"); f != 20000 {
+		t.Errorf("expected Add not to change the frequency, have %d", f)
+	}
+	words.AddWithFrequency("研究", 10)
+	if f := words.Frequency("研究"); f != 10 || words.Len() != 18 {
+		t.Errorf("expected frequency of 10 for 18 words, have %d for %d", f, words.Len())
+	}
+	var empty dictionary.WordList
+	empty.AddWithFrequency("中国", 0)
+	if f := empty.Frequency("中国"); f != 1 {
+		t.Errorf("expected frequency of 1, have %d", f)
+	}
+}
+
+func TestSegmentByFrequency(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	words, err := dictionary.LoadWordListFile("testdata/cjk-words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range []struct {
+		run, words string
+	}{
+		{"研究生命起源", "研究|生命|起源"},
+		{"我是研究生", "我|是|研究生"},
+		{"东京大学", "东京|大|学"}, // unknown ideographs stay single
+		{"東京都", "東京都"},
+		{"コンピュータプログラム", "コンピュータ|プログラム"},
+		{"", ""},
+	} {
+		run := []rune(test.run)
+		var segments []string
+		start := 0
+		for _, b := range append(words.SegmentByFrequency(run), len(run)) {
+			segments = append(segments, string(run[start:b]))
+			start = b
+		}
+		if s := strings.Join(segments, "|"); s != test.words {
+			t.Errorf("test #%d: expected %q, have %q", i, test.words, s)
+		}
+	}
+}
+
+func TestCJKBreaker(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	words, err := dictionary.LoadWordListFile("testdata/cjk-words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := "我是研究生, コンピュータプログラム OK"
+	segmenter := segment.NewSegmenter(uax29.NewWordBreaker(1), dictionary.NewCJKBreaker(words))
+	segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+	segmenter.InitFromString(text)
+	var segments []string
+	for segmenter.Next() {
+		segments = append(segments, segmenter.Text())
+	}
+	expected := []string{"我", "是", "研究生", ",", " ", "コンピュータ", "プログラム", " ", "OK"}
+	if strings.Join(segments, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected words %q, have %q", expected, segments)
+	}
+	// long runs are split
+	long := strings.Repeat("研究生命起源", 100)
+	segmenter.InitFromString(long)
+	n := 0
+	for segmenter.Next() {
+		n++
+	}
+	if n < 250 {
+		t.Errorf("expected at least 250 words, have %d", n)
+	}
+}
+
+func TestCJKBreakerPenalties(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	words, err := dictionary.LoadWordListFile("testdata/cjk-words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile := dictionary.DefaultPenalties()
+	profile.ForBreak = 200
+	for _, test := range []struct {
+		breaker *dictionary.CJKBreaker
+		penalty int
+	}{
+		{dictionary.NewCJKBreaker(words), dictionary.PenaltyForBreak},
+		{dictionary.NewCJKBreaker(words, profile), 200},
+	} {
+		segmenter := segment.NewSegmenter(uax29.NewWordBreaker(1), test.breaker)
+		segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+		segmenter.InitFromString("研究生命起源")
+		var segments []string
+		for segmenter.Next() {
+			segments = append(segments, segmenter.Text())
+			if _, p1 := segmenter.Penalties(); len(segments) < 3 && p1 != test.penalty {
+				t.Errorf("expected penalty %d after %q, have %d", test.penalty, segmenter.Text(), p1)
+			}
+		}
+		if strings.Join(segments, "|") != "研究|生命|起源" {
+			t.Errorf("expected words 研究|生命|起源, have %q", segments)
+		}
+	}
+}
@@ -137,9 +137,18 @@ will apply the following logic:
 (3) Neutral positions will have a penalty of 0. The segmenter can be configured
 to regard the zero value as breakable or not.
 
+Within a breaker, more than one rule may report a penalty for the same position.
+Rune publishers aggregate these with a PenaltyAggregator, which by default adds
+them up. Alternatives are MaxPenalties, MinPenalties and MandatoryDominates.
+
 The segmenter will aggregate penalties from its breakers and output aggregated
 penalties to the client.
 
+To find out which rules are responsible for a penalty, breakers implementing
+RuleObservable report rules being started, accepting or aborting to a
+RuleObserver. segment.Explain uses this to annotate a text with its boundaries
+and the rules involved, in the notation of the Unicode test files.
+
 ______________________________________________________________________
 
 License
@@ -173,3 +182,8 @@ const (
 	InfinitePenalty = 10000
 	InfiniteMerits  = -10000
 )
+
+// EOT is the code-point segmenters send to their breakers at the end of text.
+// It is not a valid Unicode code-point, as NUL (U+0000) may occur in a text
+// like any other character.
+const EOT rune = -1
@@ -21,25 +21,20 @@ Before using emoji classes, clients will have to initialize them.
   SetupEmojiClasses()
 
 This initializes all the code-point range tables. Initialization is
-not done beforehand, as it consumes quite some memory. */
+not done beforehand, as it consumes quite some memory.
+EmojisClassForRune does not depend on the range tables, but uses a
+pre-generated lookup table. */
 package emoji
 
 import (
 	"sync"
-	"unicode"
 )
 
 // EmojisClassForRune is the top-level client function:
 // Get the emoji class for a Unicode code-point
 // Will return -1 if the code-point has no emoji-class.
 func EmojisClassForRune(r rune) EmojisClass {
-	for c := EmojisClass(0); c <= Extended_PictographicClass; c++ {
-		urange := rangeFromEmojisClass[c]
-		if urange != nil && unicode.Is(urange, r) {
-			return c
-		}
-	}
-	return -1
+	return EmojisClass(emojisClassTable.Lookup(r))
 }
 
 var setupOnce sync.Once
@@ -38,11 +38,14 @@ import (
 	"runtime"
 	"text/template"
 	"time"
+	"unicode"
 
 	"os"
 
 	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/npillmayer/uax/internal/classtable"
 	"github.com/npillmayer/uax/internal/ucdparse"
+	"golang.org/x/text/unicode/rangetable"
 )
 
 var logger = log.New(os.Stderr, "UTS#51 generator: ", log.LstdFlags)
@@ -80,6 +83,9 @@ func loadUnicodeEmojiBreakFile() (map[string][]rune, error) {
 	for parser.Next() {
 		from, to := parser.Token.Range()
 		clstr := parser.Token.Field(1)
+		if clstr == "" { // not a data item, e.g. document root
+			continue
+		}
 		list := gcls[clstr]
 		if list == nil {
 			list = arraylist.New()
@@ -119,6 +125,7 @@ import (
     "strconv"
     "unicode"
 
+    "github.com/npillmayer/uax/internal/classtable"
     "golang.org/x/text/unicode/rangetable"
 )
 `
@@ -221,6 +228,21 @@ func generateRanges(w *bufio.Writer, codePointLists map[string][]rune) {
 	w.WriteString("}\n")
 }
 
+// generateLookupTable writes a two-stage lookup table for all classes, to be
+// used by the ClassForRune function.
+func generateLookupTable(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate lookup table")
+	classes := make([]*unicode.RangeTable, len(emojiClassnames))
+	for i, key := range emojiClassnames {
+		if codepoints, ok := codePointLists[key]; ok {
+			classes[i] = rangetable.New(codepoints...)
+		}
+	}
+	t, err := classtable.Build(classes)
+	checkFatal(err)
+	checkFatal(t.WriteGo(w, "emojisClassTable"))
+}
+
 func main() {
 	doVerbose := flag.Bool("v", false, "verbose output mode")
 	flag.Parse()
@@ -243,6 +265,7 @@ func main() {
 	t = makeTemplate("Emoji classes stringer", templateClassStringer)
 	checkFatal(t.Execute(w, emojiClassnames))
 	generateRanges(w, codePointLists)
+	generateLookupTable(w, codePointLists)
 	w.Flush()
 }
 
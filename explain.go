@@ -0,0 +1,85 @@
+package uax
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// --- Explaining rules -------------------------------------------------
+
+// RuleEventKind tells what happened to a rule.
+type RuleEventKind int
+
+// Rules are started for a code-point, and then either accept or abort. Usually
+// rules span more than one code-point, thus there may be other code-points
+// between start and accept/abort.
+const (
+	RuleStarted RuleEventKind = iota
+	RuleAccepted
+	RuleAborted
+)
+
+func (k RuleEventKind) String() string {
+	switch k {
+	case RuleStarted:
+		return "started"
+	case RuleAccepted:
+		return "accepted"
+	case RuleAborted:
+		return "aborted"
+	}
+	return fmt.Sprintf("RuleEventKind(%d)", int(k))
+}
+
+// RuleEvent is a message about the firing of a rule. It is sent to a
+// RuleObserver by a rune publisher while publishing code-point r.
+//
+// Penalties are set for accepting rules only. Like the penalties returned from
+// a UnicodeBreaker, index 0 belongs to the position after r.
+type RuleEvent struct {
+	Rule      string        // name of the rule, e.g. "WB6_7"
+	Kind      RuleEventKind // started, accepted or aborted
+	Rune      rune          // code-point being published
+	MatchLen  int           // length of the match, in code-points
+	Penalties []int         // penalties of an accepting rule
+}
+
+func (e RuleEvent) String() string {
+	if e.Kind == RuleAccepted {
+		return fmt.Sprintf("%s %s at %+q with %v", e.Rule, e.Kind, e.Rune, e.Penalties)
+	}
+	return fmt.Sprintf("%s %s at %+q", e.Rule, e.Kind, e.Rune)
+}
+
+// RuleObserver is a callback to receive rule events. It is intended for
+// debugging purposes, e.g. to find out why a break shows up at an
+// unexpected position.
+type RuleObserver func(RuleEvent)
+
+// A RuleObservable is a UnicodeBreaker which is able to report the firing of
+// its rules to a RuleObserver. Setting a nil observer switches reporting off.
+type RuleObservable interface {
+	SetRuleObserver(RuleObserver)
+}
+
+// RuleName returns the name of the rule a recognizer has been started for,
+// i.e. the name of its first state function without a "rule_" prefix.
+func (rec *Recognizer) RuleName() string {
+	if rec.rule == nil {
+		return "?"
+	}
+	name := runtime.FuncForPC(reflect.ValueOf(rec.rule).Pointer()).Name()
+	name = name[strings.LastIndexByte(name, '/')+1:]
+	name = name[strings.IndexByte(name, '.')+1:]
+	return strings.TrimPrefix(name, "rule_")
+}
+
+// ruleName returns a name for a subscriber to use in rule events.
+func ruleName(subscr RuneSubscriber) string {
+	if rec, ok := subscr.(*Recognizer); ok {
+		return rec.RuleName()
+	}
+	return fmt.Sprintf("%T", subscr)
+}
@@ -20,6 +20,12 @@ breaking engine for a segmenter.
       …
   }
 
+FastBreaker is a faster alternative to Breaker. It is driven by a
+deterministic automaton compiled from the rules of Breaker and finds the
+same boundaries, but does not support penalty profiles or rule observers:
+
+  segmenter := uax.NewSegmenter(grapheme.NewFastBreaker(1))
+
 Grapheme Strings
 
 This package provides an additional convenience type `grapheme.String`.
@@ -47,10 +53,11 @@ Usage of grapheme.String will take care of doing the setup behind the scenes.
 
 Conformance
 
-This UnicodeBreaker successfully passes all 672 tests for grapheme
-breaking of UAX#29 (GraphemeBreakTest.txt). UPDATE: Due to a small change in
-the segmenters semantics, currently 11 out of 672 tests fail. I did not have
-the time to look into it.
+This UnicodeBreaker successfully passes all 766 tests for grapheme
+breaking of UAX#29 (GraphemeBreakTest.txt) of Version, which is the version
+of the UCD files the code-point class tables have been generated from.
+This includes rule GB9c for Indic conjunct clusters, which relies on the
+property Indic_Conjunct_Break (InCB) of DerivedCoreProperties.txt.
 
 ____________________________________________________________________________
 
@@ -80,4 +87,4 @@ func tracer() tracing.Trace {
 }
 
 // Version is the Unicode version this package conforms to.
-const Version = "11.0.0"
+const Version = "17.0.0"
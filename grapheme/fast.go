@@ -0,0 +1,93 @@
+package grapheme
+
+import (
+	"unicode"
+
+	"github.com/npillmayer/uax/emoji"
+	"github.com/npillmayer/uax/internal/dfa"
+)
+
+//go:generate go run ./internal/dfagenerator
+
+// FastBreaker is a grapheme breaker driven by a deterministic automaton.
+// The automaton is compiled from the rules of Breaker, and both give
+// identical results, with FastBreaker being several times faster.
+// It implements the uax.UnicodeBreaker interface.
+//
+// FastBreaker always reports the default penalties (see DefaultPenalties).
+// It does not support penalty aggregators nor rule observers; clients
+// needing them should use Breaker.
+type FastBreaker struct {
+	machine   *dfa.Machine
+	penalties []int
+	weight    int
+}
+
+// NewFastBreaker creates a new grapheme breaker driven by a deterministic
+// automaton.
+//
+// weight is a multiplying factor for penalties. It must be 1…w…5 and will
+// be capped for values outside this range.
+func NewFastBreaker(weight int) *FastBreaker {
+	SetupGraphemeClasses()
+	return &FastBreaker{machine: dfa.NewMachine(graphemeDFA), weight: capw(weight)}
+}
+
+// Input symbols of the automaton, following the grapheme classes. Rule GB9c
+// needs Extend code-points to be told apart by their Indic_Conjunct_Break
+// property.
+const (
+	fastAny        = int(indicConsonant) + 1
+	fastInCBLinker = int(indicConsonant) + 2
+	fastInCBExtend = int(indicConsonant) + 3
+	fastEOT        = int(indicConsonant) + 4
+)
+
+// CodePointClassFor returns the input symbol of the automaton for a rune.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastBreaker) CodePointClassFor(r rune) int {
+	switch c := ClassForRune(r); c {
+	case eot:
+		return fastEOT
+	case Any:
+		if unicode.Is(emoji.Extended_Pictographic, r) {
+			return int(emojiPictographic)
+		} else if unicode.Is(inCBConsonant, r) {
+			return int(indicConsonant)
+		}
+		return fastAny
+	case ExtendClass:
+		if unicode.Is(inCBLinker, r) {
+			return fastInCBLinker
+		} else if unicode.Is(inCBExtend, r) {
+			return fastInCBExtend
+		}
+		return int(c)
+	default:
+		return int(c)
+	}
+}
+
+// StartRulesFor does nothing, as there are no rules to start.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastBreaker) StartRulesFor(r rune, cpClass int) {}
+
+// ProceedWithRune lets the automaton read a rune.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastBreaker) ProceedWithRune(r rune, cpClass int) {
+	fb.penalties = fb.machine.Step(cpClass)
+	scale(fb.penalties, fb.weight)
+}
+
+// LongestActiveMatch returns the distance to the first position the automaton
+// has not yet decided on.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastBreaker) LongestActiveMatch() int {
+	return fb.machine.LongestActiveMatch()
+}
+
+// Penalties returns the penalties for the most recently read rune.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastBreaker) Penalties() []int {
+	return fb.penalties
+}
@@ -0,0 +1,106 @@
+package grapheme
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/segment"
+)
+
+func TestFastGraphemesTestFile(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	seg := segment.NewSegmenter(NewFastBreaker(5))
+	f, err := os.Open("./testfile/GraphemeBreakTest.txt")
+	if err != nil {
+		t.Fatalf("ERROR loading ./testfile/GraphemeBreakTest.txt\n")
+	}
+	defer f.Close()
+	failcnt, i := 0, 0
+	scan := bufio.NewScanner(f)
+	for scan.Scan() {
+		line := strings.TrimSpace(scan.Text())
+		if line[0] == '#' { // ignore comment lines
+			continue
+		}
+		i++
+		in, out := breakTestInput(strings.Split(line, "#")[0])
+		if !executeSingleTest(t, seg, i, in, out) {
+			failcnt++
+		}
+	}
+	if failcnt > 0 {
+		t.Errorf("%d TEST CASES OUT of %d FAILED", failcnt, i)
+	}
+}
+
+// Differential test: the automaton has to report the same segments with the
+// same penalties as the rules it has been compiled from.
+func TestFastBreakerDifferential(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	fast := NewFastBreaker(2)
+	var symbols [][]rune // code-points for every input symbol
+	for r := rune(1); r <= unicode.MaxRune; r++ {
+		sym := fast.CodePointClassFor(r)
+		for len(symbols) <= sym {
+			symbols = append(symbols, nil)
+		}
+		symbols[sym] = append(symbols[sym], r)
+	}
+	rules := segment.NewSegmenter(NewBreaker(2))
+	auto := segment.NewSegmenter(fast)
+	random := rand.New(rand.NewSource(29))
+	for i := 0; i < 20000; i++ {
+		var b strings.Builder
+		for n := 1 + random.Intn(12); n > 0; {
+			if runes := symbols[random.Intn(len(symbols))]; len(runes) > 0 {
+				b.WriteRune(runes[random.Intn(len(runes))])
+				n--
+			}
+		}
+		text := b.String()
+		if expected, s := segmentsOf(rules, text), segmentsOf(auto, text); s != expected {
+			t.Fatalf("test #%d: %+q: expected %q, have %q", i, text, expected, s)
+		}
+	}
+}
+
+func segmentsOf(seg *segment.Segmenter, text string) string {
+	seg.InitFromString(text)
+	var segments []string
+	for seg.Next() {
+		p0, _ := seg.Penalties()
+		segments = append(segments, fmt.Sprintf("%s/%d", seg.Text(), p0))
+	}
+	return strings.Join(segments, "|")
+}
+
+func benchmarkBreaker(b *testing.B, breaker uax.UnicodeBreaker) {
+	text := strings.Repeat("Ĉu la 🇩🇪 é क्षि 👩‍❤️‍👨 한국어\r\n ", 20)
+	seg := segment.NewSegmenter(breaker)
+	b.SetBytes(int64(len(text)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		seg.InitFromString(text)
+		for seg.Next() {
+		}
+	}
+}
+
+func BenchmarkBreaker(b *testing.B) {
+	benchmarkBreaker(b, NewBreaker(1))
+}
+
+func BenchmarkFastBreaker(b *testing.B) {
+	benchmarkBreaker(b, NewFastBreaker(1))
+}
@@ -45,14 +45,11 @@ import (
 
 // ClassForRune gets the line grapheme class for a Unicode code-point.
 func ClassForRune(r rune) GraphemeClass {
-	if r == rune(0) {
+	if r == uax.EOT {
 		return eot
 	}
-	for c := GraphemeClass(0); c <= ZWJClass; c++ {
-		urange := rangeFromGraphemeClass[c]
-		if urange != nil && unicode.Is(urange, r) {
-			return c
-		}
+	if c := graphemeClassTable.Lookup(r); c >= 0 {
+		return GraphemeClass(c)
 	}
 	return Any
 }
@@ -64,7 +61,10 @@ var setupOnce sync.Once
 // Will in turn set up emoji classes as well.
 // (Concurrency-safe).
 func SetupGraphemeClasses() {
-	setupOnce.Do(setupGraphemeClasses)
+	setupOnce.Do(func() {
+		setupGraphemeClasses()
+		setupInCBClasses()
+	})
 	emoji.SetupEmojisClasses()
 }
 
@@ -81,6 +81,7 @@ type Breaker struct {
 	emojirules   map[int][]uax.NfaStateFn
 	blocked      map[GraphemeClass]bool
 	weight       int
+	profile      PenaltyProfile
 }
 
 // NewBreaker creates a new UAX#29 line breaker.
@@ -92,11 +93,15 @@ type Breaker struct {
 //   segmenter.Init(...)
 //   for segmenter.Next() ...
 //
-// weight is a multilying factor for penalties. It must be 0…w…5 and will
-// be capped for values outside this range.
+// weight is a multilying factor for penalties. It must be 1…w…5 and will
+// be capped for values outside this range. Clients may provide a penalty
+// profile to replace the default penalties (see DefaultPenalties).
 //
-func NewBreaker(weight int) *Breaker {
-	gb := &Breaker{weight: capw(weight)}
+func NewBreaker(weight int, profile ...PenaltyProfile) *Breaker {
+	gb := &Breaker{weight: capw(weight), profile: DefaultPenalties()}
+	if len(profile) > 0 {
+		gb.profile = profile[0]
+	}
 	gb.publisher = uax.NewRunePublisher()
 	//gb.publisher.SetPenaltyAggregator(uax.MaxPenalties)
 	gb.rules = map[GraphemeClass][]uax.NfaStateFn{
@@ -113,16 +118,37 @@ func NewBreaker(weight int) *Breaker {
 		ZWJClass:                {rule_GB9},
 		SpacingMarkClass:        {rule_GB9a},
 		PrependClass:            {rule_GB9b},
+		indicConsonant:          {rule_GB9c},
 		emojiPictographic:       {rule_GB11},
 		Regional_IndicatorClass: {rule_GB12},
 	}
 	gb.blocked = make(map[GraphemeClass]bool)
+	SetupGraphemeClasses() // emoji classes are needed for rule GB11
 	return gb
 }
 
+// SetPenaltyAggregator sets the function to aggregate penalties of rules
+// firing at the same position. The default is uax.AddPenalties.
+func (gb *Breaker) SetPenaltyAggregator(pa uax.PenaltyAggregator) {
+	gb.publisher.SetPenaltyAggregator(pa)
+}
+
+// SetRuleObserver sets a callback which is informed about rules being started,
+// accepting or aborting. Setting nil switches reporting off.
+//
+// (Interface uax.RuleObservable)
+func (gb *Breaker) SetRuleObserver(obs uax.RuleObserver) {
+	gb.publisher.SetRuleObserver(obs)
+}
+
 // We introduce an offest for Emoji code-point classes
 // to be able to tell them apart from grapheme classes.
-const emojiPictographic GraphemeClass = ZWJClass + 1
+// The same is true for consonants of Indic scripts (InCB=Consonant),
+// which are needed for rule GB9c.
+const (
+	emojiPictographic GraphemeClass = ZWJClass + 1
+	indicConsonant    GraphemeClass = ZWJClass + 2
+)
 
 // CodePointClassFor returns the grapheme code-point class for a rune (= code-point).
 // (Interface uax.UnicodeBreaker)
@@ -131,6 +157,8 @@ func (gb *Breaker) CodePointClassFor(r rune) int {
 	if c == Any {
 		if unicode.Is(emoji.Extended_Pictographic, r) {
 			return int(emojiPictographic)
+		} else if unicode.Is(inCBConsonant, r) {
+			return int(indicConsonant)
 		}
 	}
 	return int(c)
@@ -185,7 +213,8 @@ func (gb *Breaker) ProceedWithRune(r rune, cpClass int) {
 			}
 		}
 	*/
-	setPenalty1(gb, penalty999) //gb.penalties[1] = penalty999, if empty
+	setPenalty1(gb, gb.profile.Default) //gb.penalties[1] = penalty999, if empty
+	scale(gb.penalties, gb.weight)
 }
 
 // LongestActiveMatch collects information from
@@ -216,6 +245,31 @@ const (
 	penalty999 int = -10
 )
 
+// PenaltyProfile is a set of penalties for a Breaker to emit. A profile is
+// handed to a breaker at construction time, thus breakers with different
+// profiles may be used side by side.
+type PenaltyProfile struct {
+	ToSuppressBreak int // suppress a break (×)
+	ForMustBreak    int // mandatory break (!)
+	Default         int // rule GB999: break everywhere else (÷)
+}
+
+// DefaultPenalties returns the penalty profile breakers use if clients do not
+// provide one.
+func DefaultPenalties() PenaltyProfile {
+	return PenaltyProfile{
+		ToSuppressBreak: GlueJOIN,
+		ForMustBreak:    GlueBANG,
+		Default:         penalty999,
+	}
+}
+
+// gbPenalties returns the penalty profile of the breaker a recognizer
+// has been started by.
+func gbPenalties(rec *uax.Recognizer) *PenaltyProfile {
+	return &rec.UserData.(*Breaker).profile
+}
+
 // This is the break penalty for rule Any ÷ Any
 const penaltyForAny = GlueBREAK
 
@@ -232,7 +286,7 @@ func rule_NewLine(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("fire rule NewLine")
 	if c == LFClass {
-		return uax.DoAccept(rec, GlueBANG, GlueBANG)
+		return uax.DoAccept(rec, gbPenalties(rec).ForMustBreak, gbPenalties(rec).ForMustBreak)
 	} else if c == CRClass {
 		rec.MatchLen++
 		return rule_CRLF
@@ -244,15 +298,15 @@ func rule_CRLF(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("fire rule 05_CRLF")
 	if c == LFClass {
-		return uax.DoAccept(rec, GlueBANG, 3*GlueJOIN) // accept CR+LF
+		return uax.DoAccept(rec, gbPenalties(rec).ForMustBreak, 3*gbPenalties(rec).ToSuppressBreak) // accept CR+LF
 	}
-	return uax.DoAccept(rec, 0, GlueBANG, GlueBANG) // accept CR
+	return uax.DoAccept(rec, 0, gbPenalties(rec).ForMustBreak, gbPenalties(rec).ForMustBreak) // accept CR
 }
 
 func rule_Control(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("fire rule Control")
-	return uax.DoAccept(rec, GlueBANG, GlueBANG)
+	return uax.DoAccept(rec, gbPenalties(rec).ForMustBreak, gbPenalties(rec).ForMustBreak)
 }
 
 func rule_GB6(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
@@ -264,7 +318,7 @@ func rule_GB6(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 func rule_GB6_L_V_LV_LVT(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	if c == LClass || c == VClass || c == LVClass || c == LVTClass {
-		return uax.DoAccept(rec, 0, GlueJOIN)
+		return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -278,7 +332,7 @@ func rule_GB7(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 func rule_GB7_V_T(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	if c == VClass || c == TClass {
-		return uax.DoAccept(rec, 0, GlueJOIN)
+		return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -294,7 +348,7 @@ func rule_GB8_T(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("accept rule GB8 T")
 	if c == TClass {
-		return uax.DoAccept(rec, 0, GlueJOIN)
+		return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -302,19 +356,65 @@ func rule_GB8_T(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 func rule_GB9(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("fire rule ZWJ|Extend")
-	return uax.DoAccept(rec, 0, GlueJOIN)
+	return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 }
 
 func rule_GB9a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("fire rule SpacingMark")
-	return uax.DoAccept(rec, 0, GlueJOIN)
+	return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 }
 
+// GB9b: Prepend ×
+//
+// The penalty has to be placed when the next code-point is read; otherwise
+// the default penalty of rule GB999 would be added to it. Rules GB4 and GB5
+// take precedence for Control, CR and LF.
 func rule_GB9b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := GraphemeClass(cpClass)
 	tracer().P("class", c).Debugf("fire rule Preprend")
-	return uax.DoAccept(rec, GlueJOIN)
+	rec.MatchLen++
+	return rule_GB9bCont
+}
+
+func rule_GB9bCont(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := GraphemeClass(cpClass)
+	if c == ControlClass || c == CRClass || c == LFClass || c == eot {
+		return uax.DoAbort(rec)
+	}
+	return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
+}
+
+// GB9c: Do not break within certain combinations with Indic_Conjunct_Break (InCB)=Linker.
+//
+// \p{InCB=Consonant} [\p{InCB=Extend}\p{InCB=Linker}]* \p{InCB=Linker}
+// [\p{InCB=Extend}\p{InCB=Linker}]* × \p{InCB=Consonant}
+func rule_GB9c(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	tracer().P("class", cpClass).Debugf("fire rule InCB consonant")
+	return rule_GB9cCont
+}
+
+// ... [\p{InCB=Extend}\p{InCB=Linker}]* \p{InCB=Linker}
+func rule_GB9cCont(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if unicode.Is(inCBLinker, r) {
+		rec.MatchLen++
+		return rule_GB9cLinked
+	} else if unicode.Is(inCBExtend, r) {
+		rec.MatchLen++
+		return rule_GB9cCont
+	}
+	return uax.DoAbort(rec)
+}
+
+// ... [\p{InCB=Extend}\p{InCB=Linker}]* × \p{InCB=Consonant}
+func rule_GB9cLinked(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if GraphemeClass(cpClass) == indicConsonant {
+		return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
+	} else if unicode.Is(inCBLinker, r) || unicode.Is(inCBExtend, r) {
+		rec.MatchLen++
+		return rule_GB9cLinked
+	}
+	return uax.DoAbort(rec)
 }
 
 func rule_GB11(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
@@ -335,7 +435,7 @@ func rule_GB11Cont(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 
 func rule_GB11Finish(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	if cpClass == int(emojiPictographic) {
-		return uax.DoAccept(rec, 0, GlueJOIN)
+		return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -352,7 +452,7 @@ func rule_GB12Cont(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	gb := rec.UserData.(*Breaker)
 	gb.unblock(Regional_IndicatorClass)
 	if c == Regional_IndicatorClass {
-		return uax.DoAccept(rec, 0, GlueJOIN)
+		return uax.DoAccept(rec, 0, gbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -360,8 +460,8 @@ func rule_GB12Cont(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 // ---------------------------------------------------------------------------
 
 func capw(w int) int {
-	if w < 0 {
-		return w
+	if w < 1 {
+		return 1
 	}
 	if w > 5 {
 		return 5
@@ -369,6 +469,16 @@ func capw(w int) int {
 	return w
 }
 
+// scale multiplies penalties by a breaker's weight.
+func scale(penalties []int, w int) {
+	if w == 1 {
+		return
+	}
+	for i := range penalties {
+		penalties[i] *= w
+	}
+}
+
 func setPenalty1(gb *Breaker, p int) {
 	if len(gb.penalties) == 0 {
 		gb.penalties = append(gb.penalties, 0)
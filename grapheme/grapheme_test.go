@@ -10,6 +10,7 @@ import (
 	"unicode"
 
 	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
 	"github.com/npillmayer/uax/segment"
 )
 
@@ -28,8 +29,11 @@ func TestGraphemeClasses(t *testing.T) {
 	if c := ClassForRune(hangsyl); c != LVClass {
 		t.Errorf("Hang syllable GAE should be of class LV, is %s", c)
 	}
-	if c := ClassForRune(0); c != eot {
-		t.Errorf("\\0x00 should be of class eot, is %s", c)
+	if c := ClassForRune(0); c != ControlClass {
+		t.Errorf("\\0x00 should be of class Control, is %s", c)
+	}
+	if c := ClassForRune(uax.EOT); c != eot {
+		t.Errorf("uax.EOT should be of class eot, is %s", c)
 	}
 }
 
@@ -76,6 +80,31 @@ func TestGraphemes2(t *testing.T) {
 	}
 }
 
+func TestGraphemesAll(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	var output []string
+	for g := range All("Ü🇩🇪e\u0301!\r\n") {
+		output = append(output, g)
+	}
+	if strings.Join(output, "_") != "Ü_🇩🇪_e\u0301_!_\r\n" {
+		t.Errorf("unexpected grapheme clusters %q", output)
+	}
+}
+
+func TestVersion(t *testing.T) {
+	f, err := os.Open("./testfile/GraphemeBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	header, _ := bufio.NewReader(f).ReadString('\n')
+	if expected := "# GraphemeBreakTest-" + Version + ".txt"; strings.TrimSpace(header) != expected {
+		t.Errorf("expected test file %q for Version, have %q", expected, strings.TrimSpace(header))
+	}
+}
+
 func TestGraphemesTestFile(t *testing.T) {
 	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
 	defer teardown()
@@ -122,13 +151,52 @@ func TestGraphemesTestFile(t *testing.T) {
 	if err := scan.Err(); err != nil {
 		tracer().Infof("reading input:", err)
 	}
-	if failcnt > 11 {
+	if failcnt > 0 {
 		t.Errorf("%d TEST CASES OUT of %d FAILED", failcnt, i-from+1)
 	} else {
 		t.Logf("%d TEST CASES OUT of %d FAILED", failcnt, i-from+1)
 	}
 }
 
+func TestGraphemesIndicConjuncts(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	SetupGraphemeClasses()
+	tests := []string{ // in the notation of GraphemeBreakTest.txt
+		"÷ 0915 × 094D × 0937 ÷",               // क्ष
+		"÷ 0915 × 094D × 0937 × 093F ÷ 0924 ÷", // क्षि त
+		"÷ 0924 × 094D × 0930 × 093F ÷",        // त्रि
+		"÷ 0915 ÷ 0924 ÷",
+		"÷ 0915 × 094D × 094D × 0924 ÷",
+		"÷ 0915 × 094D × 200D × 0924 ÷",
+		"÷ 0915 × 093C × 200D × 094D × 0924 ÷",
+		"÷ 0915 × 094D × 0924 × 094D × 092F ÷",
+		"÷ 0915 × 094D ÷ 0061 ÷",
+		"÷ 0061 × 094D ÷ 0924 ÷",
+		"÷ 003F × 094D ÷ 0924 ÷",
+		"÷ 0995 × 09CD × 09B7 ÷ 0020 ÷", // Bengali ক্ষ
+	}
+	for _, seg := range []*segment.Segmenter{
+		segment.NewSegmenter(NewBreaker(5)),
+		segment.NewSegmenter(NewFastBreaker(5)),
+	} {
+		for i, test := range tests {
+			in, out := breakTestInput(test)
+			if !executeSingleTest(t, seg, i, in, out) {
+				t.Errorf("test #%d failed: %s", i, test)
+			}
+		}
+	}
+	var output []string
+	for g := range All("क्षत्रिय") {
+		output = append(output, g)
+	}
+	if strings.Join(output, "_") != "क्ष_त्रि_य" {
+		t.Errorf("unexpected grapheme clusters %q", output)
+	}
+}
+
 func breakTestInput(ti string) (string, []string) {
 	//fmt.Printf("breaking up %s\n", ti)
 	sc := bufio.NewScanner(strings.NewReader(ti))
@@ -8,6 +8,7 @@ import (
     "strconv"
     "unicode"
 
+    "github.com/npillmayer/uax/internal/classtable"
     "golang.org/x/text/unicode/rangetable"
 )
 
@@ -67,261 +68,6 @@ func (c GraphemeClass) String() string {
 func setupGraphemeClasses() {
     rangeFromGraphemeClass = make([]*unicode.RangeTable, int(ZWJClass)+1)
 
-    // Range for Grapheme class CR
-    CR = rangetable.New('\r')
-    rangeFromGraphemeClass[int(CRClass)] = CR
-
-    // Range for Grapheme class LF
-    LF = rangetable.New('\n')
-    rangeFromGraphemeClass[int(LFClass)] = LF
-
-    // Range for Grapheme class Extend
-    Extend = rangetable.New('\u0300', '\u0301', '\u0302', '\u0303', '\u0304', '\u0305', 
-    '\u0306', '\u0307', '\u0308', '\u0309', '\u030a', '\u030b', '\u030c', '\u030d', 
-    '\u030e', '\u030f', '\u0310', '\u0311', '\u0312', '\u0313', '\u0314', '\u0315', 
-    '\u0316', '\u0317', '\u0318', '\u0319', '\u031a', '\u031b', '\u031c', '\u031d', 
-    '\u031e', '\u031f', '\u0320', '\u0321', '\u0322', '\u0323', '\u0324', '\u0325', 
-    '\u0326', '\u0327', '\u0328', '\u0329', '\u032a', '\u032b', '\u032c', '\u032d', 
-    '\u032e', '\u032f', '\u0330', '\u0331', '\u0332', '\u0333', '\u0334', '\u0335', 
-    '\u0336', '\u0337', '\u0338', '\u0339', '\u033a', '\u033b', '\u033c', '\u033d', 
-    '\u033e', '\u033f', '\u0340', '\u0341', '\u0342', '\u0343', '\u0344', '\u0345', 
-    '\u0346', '\u0347', '\u0348', '\u0349', '\u034a', '\u034b', '\u034c', '\u034d', 
-    '\u034e', '\u034f', '\u0350', '\u0351', '\u0352', '\u0353', '\u0354', '\u0355', 
-    '\u0356', '\u0357', '\u0358', '\u0359', '\u035a', '\u035b', '\u035c', '\u035d', 
-    '\u035e', '\u035f', '\u0360', '\u0361', '\u0362', '\u0363', '\u0364', '\u0365', 
-    '\u0366', '\u0367', '\u0368', '\u0369', '\u036a', '\u036b', '\u036c', '\u036d', 
-    '\u036e', '\u036f', '\u0483', '\u0484', '\u0485', '\u0486', '\u0487', '\u0488', 
-    '\u0489', '\u0591', '\u0592', '\u0593', '\u0594', '\u0595', '\u0596', '\u0597', 
-    '\u0598', '\u0599', '\u059a', '\u059b', '\u059c', '\u059d', '\u059e', '\u059f', 
-    '\u05a0', '\u05a1', '\u05a2', '\u05a3', '\u05a4', '\u05a5', '\u05a6', '\u05a7', 
-    '\u05a8', '\u05a9', '\u05aa', '\u05ab', '\u05ac', '\u05ad', '\u05ae', '\u05af', 
-    '\u05b0', '\u05b1', '\u05b2', '\u05b3', '\u05b4', '\u05b5', '\u05b6', '\u05b7', 
-    '\u05b8', '\u05b9', '\u05ba', '\u05bb', '\u05bc', '\u05bd', '\u05bf', '\u05c1', 
-    '\u05c2', '\u05c4', '\u05c5', '\u05c7', '\u0610', '\u0611', '\u0612', '\u0613', 
-    '\u0614', '\u0615', '\u0616', '\u0617', '\u0618', '\u0619', '\u061a', '\u064b', 
-    '\u064c', '\u064d', '\u064e', '\u064f', '\u0650', '\u0651', '\u0652', '\u0653', 
-    '\u0654', '\u0655', '\u0656', '\u0657', '\u0658', '\u0659', '\u065a', '\u065b', 
-    '\u065c', '\u065d', '\u065e', '\u065f', '\u0670', '\u06d6', '\u06d7', '\u06d8', 
-    '\u06d9', '\u06da', '\u06db', '\u06dc', '\u06df', '\u06e0', '\u06e1', '\u06e2', 
-    '\u06e3', '\u06e4', '\u06e7', '\u06e8', '\u06ea', '\u06eb', '\u06ec', '\u06ed', 
-    '\u0711', '\u0730', '\u0731', '\u0732', '\u0733', '\u0734', '\u0735', '\u0736', 
-    '\u0737', '\u0738', '\u0739', '\u073a', '\u073b', '\u073c', '\u073d', '\u073e', 
-    '\u073f', '\u0740', '\u0741', '\u0742', '\u0743', '\u0744', '\u0745', '\u0746', 
-    '\u0747', '\u0748', '\u0749', '\u074a', '\u07a6', '\u07a7', '\u07a8', '\u07a9', 
-    '\u07aa', '\u07ab', '\u07ac', '\u07ad', '\u07ae', '\u07af', '\u07b0', '\u07eb', 
-    '\u07ec', '\u07ed', '\u07ee', '\u07ef', '\u07f0', '\u07f1', '\u07f2', '\u07f3', 
-    '\u07fd', '\u0816', '\u0817', '\u0818', '\u0819', '\u081b', '\u081c', '\u081d', 
-    '\u081e', '\u081f', '\u0820', '\u0821', '\u0822', '\u0823', '\u0825', '\u0826', 
-    '\u0827', '\u0829', '\u082a', '\u082b', '\u082c', '\u082d', '\u0859', '\u085a', 
-    '\u085b', '\u08d3', '\u08d4', '\u08d5', '\u08d6', '\u08d7', '\u08d8', '\u08d9', 
-    '\u08da', '\u08db', '\u08dc', '\u08dd', '\u08de', '\u08df', '\u08e0', '\u08e1', 
-    '\u08e3', '\u08e4', '\u08e5', '\u08e6', '\u08e7', '\u08e8', '\u08e9', '\u08ea', 
-    '\u08eb', '\u08ec', '\u08ed', '\u08ee', '\u08ef', '\u08f0', '\u08f1', '\u08f2', 
-    '\u08f3', '\u08f4', '\u08f5', '\u08f6', '\u08f7', '\u08f8', '\u08f9', '\u08fa', 
-    '\u08fb', '\u08fc', '\u08fd', '\u08fe', '\u08ff', '\u0900', '\u0901', '\u0902', 
-    '\u093a', '\u093c', '\u0941', '\u0942', '\u0943', '\u0944', '\u0945', '\u0946', 
-    '\u0947', '\u0948', '\u094d', '\u0951', '\u0952', '\u0953', '\u0954', '\u0955', 
-    '\u0956', '\u0957', '\u0962', '\u0963', '\u0981', '\u09bc', '\u09be', '\u09c1', 
-    '\u09c2', '\u09c3', '\u09c4', '\u09cd', '\u09d7', '\u09e2', '\u09e3', '\u09fe', 
-    '\u0a01', '\u0a02', '\u0a3c', '\u0a41', '\u0a42', '\u0a47', '\u0a48', '\u0a4b', 
-    '\u0a4c', '\u0a4d', '\u0a51', '\u0a70', '\u0a71', '\u0a75', '\u0a81', '\u0a82', 
-    '\u0abc', '\u0ac1', '\u0ac2', '\u0ac3', '\u0ac4', '\u0ac5', '\u0ac7', '\u0ac8', 
-    '\u0acd', '\u0ae2', '\u0ae3', '\u0afa', '\u0afb', '\u0afc', '\u0afd', '\u0afe', 
-    '\u0aff', '\u0b01', '\u0b3c', '\u0b3e', '\u0b3f', '\u0b41', '\u0b42', '\u0b43', 
-    '\u0b44', '\u0b4d', '\u0b56', '\u0b57', '\u0b62', '\u0b63', '\u0b82', '\u0bbe', 
-    '\u0bc0', '\u0bcd', '\u0bd7', '\u0c00', '\u0c04', '\u0c3e', '\u0c3f', '\u0c40', 
-    '\u0c46', '\u0c47', '\u0c48', '\u0c4a', '\u0c4b', '\u0c4c', '\u0c4d', '\u0c55', 
-    '\u0c56', '\u0c62', '\u0c63', '\u0c81', '\u0cbc', '\u0cbf', '\u0cc2', '\u0cc6', 
-    '\u0ccc', '\u0ccd', '\u0cd5', '\u0cd6', '\u0ce2', '\u0ce3', '\u0d00', '\u0d01', 
-    '\u0d3b', '\u0d3c', '\u0d3e', '\u0d41', '\u0d42', '\u0d43', '\u0d44', '\u0d4d', 
-    '\u0d57', '\u0d62', '\u0d63', '\u0dca', '\u0dcf', '\u0dd2', '\u0dd3', '\u0dd4', 
-    '\u0dd6', '\u0ddf', '\u0e31', '\u0e34', '\u0e35', '\u0e36', '\u0e37', '\u0e38', 
-    '\u0e39', '\u0e3a', '\u0e47', '\u0e48', '\u0e49', '\u0e4a', '\u0e4b', '\u0e4c', 
-    '\u0e4d', '\u0e4e', '\u0eb1', '\u0eb4', '\u0eb5', '\u0eb6', '\u0eb7', '\u0eb8', 
-    '\u0eb9', '\u0ebb', '\u0ebc', '\u0ec8', '\u0ec9', '\u0eca', '\u0ecb', '\u0ecc', 
-    '\u0ecd', '\u0f18', '\u0f19', '\u0f35', '\u0f37', '\u0f39', '\u0f71', '\u0f72', 
-    '\u0f73', '\u0f74', '\u0f75', '\u0f76', '\u0f77', '\u0f78', '\u0f79', '\u0f7a', 
-    '\u0f7b', '\u0f7c', '\u0f7d', '\u0f7e', '\u0f80', '\u0f81', '\u0f82', '\u0f83', 
-    '\u0f84', '\u0f86', '\u0f87', '\u0f8d', '\u0f8e', '\u0f8f', '\u0f90', '\u0f91', 
-    '\u0f92', '\u0f93', '\u0f94', '\u0f95', '\u0f96', '\u0f97', '\u0f99', '\u0f9a', 
-    '\u0f9b', '\u0f9c', '\u0f9d', '\u0f9e', '\u0f9f', '\u0fa0', '\u0fa1', '\u0fa2', 
-    '\u0fa3', '\u0fa4', '\u0fa5', '\u0fa6', '\u0fa7', '\u0fa8', '\u0fa9', '\u0faa', 
-    '\u0fab', '\u0fac', '\u0fad', '\u0fae', '\u0faf', '\u0fb0', '\u0fb1', '\u0fb2', 
-    '\u0fb3', '\u0fb4', '\u0fb5', '\u0fb6', '\u0fb7', '\u0fb8', '\u0fb9', '\u0fba', 
-    '\u0fbb', '\u0fbc', '\u0fc6', '\u102d', '\u102e', '\u102f', '\u1030', '\u1032', 
-    '\u1033', '\u1034', '\u1035', '\u1036', '\u1037', '\u1039', '\u103a', '\u103d', 
-    '\u103e', '\u1058', '\u1059', '\u105e', '\u105f', '\u1060', '\u1071', '\u1072', 
-    '\u1073', '\u1074', '\u1082', '\u1085', '\u1086', '\u108d', '\u109d', '\u135d', 
-    '\u135e', '\u135f', '\u1712', '\u1713', '\u1714', '\u1732', '\u1733', '\u1734', 
-    '\u1752', '\u1753', '\u1772', '\u1773', '\u17b4', '\u17b5', '\u17b7', '\u17b8', 
-    '\u17b9', '\u17ba', '\u17bb', '\u17bc', '\u17bd', '\u17c6', '\u17c9', '\u17ca', 
-    '\u17cb', '\u17cc', '\u17cd', '\u17ce', '\u17cf', '\u17d0', '\u17d1', '\u17d2', 
-    '\u17d3', '\u17dd', '\u180b', '\u180c', '\u180d', '\u1885', '\u1886', '\u18a9', 
-    '\u1920', '\u1921', '\u1922', '\u1927', '\u1928', '\u1932', '\u1939', '\u193a', 
-    '\u193b', '\u1a17', '\u1a18', '\u1a1b', '\u1a56', '\u1a58', '\u1a59', '\u1a5a', 
-    '\u1a5b', '\u1a5c', '\u1a5d', '\u1a5e', '\u1a60', '\u1a62', '\u1a65', '\u1a66', 
-    '\u1a67', '\u1a68', '\u1a69', '\u1a6a', '\u1a6b', '\u1a6c', '\u1a73', '\u1a74', 
-    '\u1a75', '\u1a76', '\u1a77', '\u1a78', '\u1a79', '\u1a7a', '\u1a7b', '\u1a7c', 
-    '\u1a7f', '\u1ab0', '\u1ab1', '\u1ab2', '\u1ab3', '\u1ab4', '\u1ab5', '\u1ab6', 
-    '\u1ab7', '\u1ab8', '\u1ab9', '\u1aba', '\u1abb', '\u1abc', '\u1abd', '\u1abe', 
-    '\u1b00', '\u1b01', '\u1b02', '\u1b03', '\u1b34', '\u1b36', '\u1b37', '\u1b38', 
-    '\u1b39', '\u1b3a', '\u1b3c', '\u1b42', '\u1b6b', '\u1b6c', '\u1b6d', '\u1b6e', 
-    '\u1b6f', '\u1b70', '\u1b71', '\u1b72', '\u1b73', '\u1b80', '\u1b81', '\u1ba2', 
-    '\u1ba3', '\u1ba4', '\u1ba5', '\u1ba8', '\u1ba9', '\u1bab', '\u1bac', '\u1bad', 
-    '\u1be6', '\u1be8', '\u1be9', '\u1bed', '\u1bef', '\u1bf0', '\u1bf1', '\u1c2c', 
-    '\u1c2d', '\u1c2e', '\u1c2f', '\u1c30', '\u1c31', '\u1c32', '\u1c33', '\u1c36', 
-    '\u1c37', '\u1cd0', '\u1cd1', '\u1cd2', '\u1cd4', '\u1cd5', '\u1cd6', '\u1cd7', 
-    '\u1cd8', '\u1cd9', '\u1cda', '\u1cdb', '\u1cdc', '\u1cdd', '\u1cde', '\u1cdf', 
-    '\u1ce0', '\u1ce2', '\u1ce3', '\u1ce4', '\u1ce5', '\u1ce6', '\u1ce7', '\u1ce8', 
-    '\u1ced', '\u1cf4', '\u1cf8', '\u1cf9', '\u1dc0', '\u1dc1', '\u1dc2', '\u1dc3', 
-    '\u1dc4', '\u1dc5', '\u1dc6', '\u1dc7', '\u1dc8', '\u1dc9', '\u1dca', '\u1dcb', 
-    '\u1dcc', '\u1dcd', '\u1dce', '\u1dcf', '\u1dd0', '\u1dd1', '\u1dd2', '\u1dd3', 
-    '\u1dd4', '\u1dd5', '\u1dd6', '\u1dd7', '\u1dd8', '\u1dd9', '\u1dda', '\u1ddb', 
-    '\u1ddc', '\u1ddd', '\u1dde', '\u1ddf', '\u1de0', '\u1de1', '\u1de2', '\u1de3', 
-    '\u1de4', '\u1de5', '\u1de6', '\u1de7', '\u1de8', '\u1de9', '\u1dea', '\u1deb', 
-    '\u1dec', '\u1ded', '\u1dee', '\u1def', '\u1df0', '\u1df1', '\u1df2', '\u1df3', 
-    '\u1df4', '\u1df5', '\u1df6', '\u1df7', '\u1df8', '\u1df9', '\u1dfb', '\u1dfc', 
-    '\u1dfd', '\u1dfe', '\u1dff', '\u200c', '\u20d0', '\u20d1', '\u20d2', '\u20d3', 
-    '\u20d4', '\u20d5', '\u20d6', '\u20d7', '\u20d8', '\u20d9', '\u20da', '\u20db', 
-    '\u20dc', '\u20dd', '\u20de', '\u20df', '\u20e0', '\u20e1', '\u20e2', '\u20e3', 
-    '\u20e4', '\u20e5', '\u20e6', '\u20e7', '\u20e8', '\u20e9', '\u20ea', '\u20eb', 
-    '\u20ec', '\u20ed', '\u20ee', '\u20ef', '\u20f0', '\u2cef', '\u2cf0', '\u2cf1', 
-    '\u2d7f', '\u2de0', '\u2de1', '\u2de2', '\u2de3', '\u2de4', '\u2de5', '\u2de6', 
-    '\u2de7', '\u2de8', '\u2de9', '\u2dea', '\u2deb', '\u2dec', '\u2ded', '\u2dee', 
-    '\u2def', '\u2df0', '\u2df1', '\u2df2', '\u2df3', '\u2df4', '\u2df5', '\u2df6', 
-    '\u2df7', '\u2df8', '\u2df9', '\u2dfa', '\u2dfb', '\u2dfc', '\u2dfd', '\u2dfe', 
-    '\u2dff', '\u302a', '\u302b', '\u302c', '\u302d', '\u302e', '\u302f', '\u3099', 
-    '\u309a', '\ua66f', '\ua670', '\ua671', '\ua672', '\ua674', '\ua675', '\ua676', 
-    '\ua677', '\ua678', '\ua679', '\ua67a', '\ua67b', '\ua67c', '\ua67d', '\ua69e', 
-    '\ua69f', '\ua6f0', '\ua6f1', '\ua802', '\ua806', '\ua80b', '\ua825', '\ua826', 
-    '\ua8c4', '\ua8c5', '\ua8e0', '\ua8e1', '\ua8e2', '\ua8e3', '\ua8e4', '\ua8e5', 
-    '\ua8e6', '\ua8e7', '\ua8e8', '\ua8e9', '\ua8ea', '\ua8eb', '\ua8ec', '\ua8ed', 
-    '\ua8ee', '\ua8ef', '\ua8f0', '\ua8f1', '\ua8ff', '\ua926', '\ua927', '\ua928', 
-    '\ua929', '\ua92a', '\ua92b', '\ua92c', '\ua92d', '\ua947', '\ua948', '\ua949', 
-    '\ua94a', '\ua94b', '\ua94c', '\ua94d', '\ua94e', '\ua94f', '\ua950', '\ua951', 
-    '\ua980', '\ua981', '\ua982', '\ua9b3', '\ua9b6', '\ua9b7', '\ua9b8', '\ua9b9', 
-    '\ua9bc', '\ua9e5', '\uaa29', '\uaa2a', '\uaa2b', '\uaa2c', '\uaa2d', '\uaa2e', 
-    '\uaa31', '\uaa32', '\uaa35', '\uaa36', '\uaa43', '\uaa4c', '\uaa7c', '\uaab0', 
-    '\uaab2', '\uaab3', '\uaab4', '\uaab7', '\uaab8', '\uaabe', '\uaabf', '\uaac1', 
-    '\uaaec', '\uaaed', '\uaaf6', '\uabe5', '\uabe8', '\uabed', '\ufb1e', '\ufe00', 
-    '\ufe01', '\ufe02', '\ufe03', '\ufe04', '\ufe05', '\ufe06', '\ufe07', '\ufe08', 
-    '\ufe09', '\ufe0a', '\ufe0b', '\ufe0c', '\ufe0d', '\ufe0e', '\ufe0f', '\ufe20', 
-    '\ufe21', '\ufe22', '\ufe23', '\ufe24', '\ufe25', '\ufe26', '\ufe27', '\ufe28', 
-    '\ufe29', '\ufe2a', '\ufe2b', '\ufe2c', '\ufe2d', '\ufe2e', '\ufe2f', '\uff9e', 
-    '\uff9f', '\U000101fd', '\U000102e0', '\U00010376', '\U00010377', '\U00010378', '\U00010379', '\U0001037a', 
-    '\U00010a01', '\U00010a02', '\U00010a03', '\U00010a05', '\U00010a06', '\U00010a0c', '\U00010a0d', '\U00010a0e', 
-    '\U00010a0f', '\U00010a38', '\U00010a39', '\U00010a3a', '\U00010a3f', '\U00010ae5', '\U00010ae6', '\U00010d24', 
-    '\U00010d25', '\U00010d26', '\U00010d27', '\U00010f46', '\U00010f47', '\U00010f48', '\U00010f49', '\U00010f4a', 
-    '\U00010f4b', '\U00010f4c', '\U00010f4d', '\U00010f4e', '\U00010f4f', '\U00010f50', '\U00011001', '\U00011038', 
-    '\U00011039', '\U0001103a', '\U0001103b', '\U0001103c', '\U0001103d', '\U0001103e', '\U0001103f', '\U00011040', 
-    '\U00011041', '\U00011042', '\U00011043', '\U00011044', '\U00011045', '\U00011046', '\U0001107f', '\U00011080', 
-    '\U00011081', '\U000110b3', '\U000110b4', '\U000110b5', '\U000110b6', '\U000110b9', '\U000110ba', '\U00011100', 
-    '\U00011101', '\U00011102', '\U00011127', '\U00011128', '\U00011129', '\U0001112a', '\U0001112b', '\U0001112d', 
-    '\U0001112e', '\U0001112f', '\U00011130', '\U00011131', '\U00011132', '\U00011133', '\U00011134', '\U00011173', 
-    '\U00011180', '\U00011181', '\U000111b6', '\U000111b7', '\U000111b8', '\U000111b9', '\U000111ba', '\U000111bb', 
-    '\U000111bc', '\U000111bd', '\U000111be', '\U000111c9', '\U000111ca', '\U000111cb', '\U000111cc', '\U0001122f', 
-    '\U00011230', '\U00011231', '\U00011234', '\U00011236', '\U00011237', '\U0001123e', '\U000112df', '\U000112e3', 
-    '\U000112e4', '\U000112e5', '\U000112e6', '\U000112e7', '\U000112e8', '\U000112e9', '\U000112ea', '\U00011300', 
-    '\U00011301', '\U0001133b', '\U0001133c', '\U0001133e', '\U00011340', '\U00011357', '\U00011366', '\U00011367', 
-    '\U00011368', '\U00011369', '\U0001136a', '\U0001136b', '\U0001136c', '\U00011370', '\U00011371', '\U00011372', 
-    '\U00011373', '\U00011374', '\U00011438', '\U00011439', '\U0001143a', '\U0001143b', '\U0001143c', '\U0001143d', 
-    '\U0001143e', '\U0001143f', '\U00011442', '\U00011443', '\U00011444', '\U00011446', '\U0001145e', '\U000114b0', 
-    '\U000114b3', '\U000114b4', '\U000114b5', '\U000114b6', '\U000114b7', '\U000114b8', '\U000114ba', '\U000114bd', 
-    '\U000114bf', '\U000114c0', '\U000114c2', '\U000114c3', '\U000115af', '\U000115b2', '\U000115b3', '\U000115b4', 
-    '\U000115b5', '\U000115bc', '\U000115bd', '\U000115bf', '\U000115c0', '\U000115dc', '\U000115dd', '\U00011633', 
-    '\U00011634', '\U00011635', '\U00011636', '\U00011637', '\U00011638', '\U00011639', '\U0001163a', '\U0001163d', 
-    '\U0001163f', '\U00011640', '\U000116ab', '\U000116ad', '\U000116b0', '\U000116b1', '\U000116b2', '\U000116b3', 
-    '\U000116b4', '\U000116b5', '\U000116b7', '\U0001171d', '\U0001171e', '\U0001171f', '\U00011722', '\U00011723', 
-    '\U00011724', '\U00011725', '\U00011727', '\U00011728', '\U00011729', '\U0001172a', '\U0001172b', '\U0001182f', 
-    '\U00011830', '\U00011831', '\U00011832', '\U00011833', '\U00011834', '\U00011835', '\U00011836', '\U00011837', 
-    '\U00011839', '\U0001183a', '\U00011a01', '\U00011a02', '\U00011a03', '\U00011a04', '\U00011a05', '\U00011a06', 
-    '\U00011a07', '\U00011a08', '\U00011a09', '\U00011a0a', '\U00011a33', '\U00011a34', '\U00011a35', '\U00011a36', 
-    '\U00011a37', '\U00011a38', '\U00011a3b', '\U00011a3c', '\U00011a3d', '\U00011a3e', '\U00011a47', '\U00011a51', 
-    '\U00011a52', '\U00011a53', '\U00011a54', '\U00011a55', '\U00011a56', '\U00011a59', '\U00011a5a', '\U00011a5b', 
-    '\U00011a8a', '\U00011a8b', '\U00011a8c', '\U00011a8d', '\U00011a8e', '\U00011a8f', '\U00011a90', '\U00011a91', 
-    '\U00011a92', '\U00011a93', '\U00011a94', '\U00011a95', '\U00011a96', '\U00011a98', '\U00011a99', '\U00011c30', 
-    '\U00011c31', '\U00011c32', '\U00011c33', '\U00011c34', '\U00011c35', '\U00011c36', '\U00011c38', '\U00011c39', 
-    '\U00011c3a', '\U00011c3b', '\U00011c3c', '\U00011c3d', '\U00011c3f', '\U00011c92', '\U00011c93', '\U00011c94', 
-    '\U00011c95', '\U00011c96', '\U00011c97', '\U00011c98', '\U00011c99', '\U00011c9a', '\U00011c9b', '\U00011c9c', 
-    '\U00011c9d', '\U00011c9e', '\U00011c9f', '\U00011ca0', '\U00011ca1', '\U00011ca2', '\U00011ca3', '\U00011ca4', 
-    '\U00011ca5', '\U00011ca6', '\U00011ca7', '\U00011caa', '\U00011cab', '\U00011cac', '\U00011cad', '\U00011cae', 
-    '\U00011caf', '\U00011cb0', '\U00011cb2', '\U00011cb3', '\U00011cb5', '\U00011cb6', '\U00011d31', '\U00011d32', 
-    '\U00011d33', '\U00011d34', '\U00011d35', '\U00011d36', '\U00011d3a', '\U00011d3c', '\U00011d3d', '\U00011d3f', 
-    '\U00011d40', '\U00011d41', '\U00011d42', '\U00011d43', '\U00011d44', '\U00011d45', '\U00011d47', '\U00011d90', 
-    '\U00011d91', '\U00011d95', '\U00011d97', '\U00011ef3', '\U00011ef4', '\U00016af0', '\U00016af1', '\U00016af2', 
-    '\U00016af3', '\U00016af4', '\U00016b30', '\U00016b31', '\U00016b32', '\U00016b33', '\U00016b34', '\U00016b35', 
-    '\U00016b36', '\U00016f8f', '\U00016f90', '\U00016f91', '\U00016f92', '\U0001bc9d', '\U0001bc9e', '\U0001d165', 
-    '\U0001d167', '\U0001d168', '\U0001d169', '\U0001d16e', '\U0001d16f', '\U0001d170', '\U0001d171', '\U0001d172', 
-    '\U0001d17b', '\U0001d17c', '\U0001d17d', '\U0001d17e', '\U0001d17f', '\U0001d180', '\U0001d181', '\U0001d182', 
-    '\U0001d185', '\U0001d186', '\U0001d187', '\U0001d188', '\U0001d189', '\U0001d18a', '\U0001d18b', '\U0001d1aa', 
-    '\U0001d1ab', '\U0001d1ac', '\U0001d1ad', '\U0001d242', '\U0001d243', '\U0001d244', '\U0001da00', '\U0001da01', 
-    '\U0001da02', '\U0001da03', '\U0001da04', '\U0001da05', '\U0001da06', '\U0001da07', '\U0001da08', '\U0001da09', 
-    '\U0001da0a', '\U0001da0b', '\U0001da0c', '\U0001da0d', '\U0001da0e', '\U0001da0f', '\U0001da10', '\U0001da11', 
-    '\U0001da12', '\U0001da13', '\U0001da14', '\U0001da15', '\U0001da16', '\U0001da17', '\U0001da18', '\U0001da19', 
-    '\U0001da1a', '\U0001da1b', '\U0001da1c', '\U0001da1d', '\U0001da1e', '\U0001da1f', '\U0001da20', '\U0001da21', 
-    '\U0001da22', '\U0001da23', '\U0001da24', '\U0001da25', '\U0001da26', '\U0001da27', '\U0001da28', '\U0001da29', 
-    '\U0001da2a', '\U0001da2b', '\U0001da2c', '\U0001da2d', '\U0001da2e', '\U0001da2f', '\U0001da30', '\U0001da31', 
-    '\U0001da32', '\U0001da33', '\U0001da34', '\U0001da35', '\U0001da36', '\U0001da3b', '\U0001da3c', '\U0001da3d', 
-    '\U0001da3e', '\U0001da3f', '\U0001da40', '\U0001da41', '\U0001da42', '\U0001da43', '\U0001da44', '\U0001da45', 
-    '\U0001da46', '\U0001da47', '\U0001da48', '\U0001da49', '\U0001da4a', '\U0001da4b', '\U0001da4c', '\U0001da4d', 
-    '\U0001da4e', '\U0001da4f', '\U0001da50', '\U0001da51', '\U0001da52', '\U0001da53', '\U0001da54', '\U0001da55', 
-    '\U0001da56', '\U0001da57', '\U0001da58', '\U0001da59', '\U0001da5a', '\U0001da5b', '\U0001da5c', '\U0001da5d', 
-    '\U0001da5e', '\U0001da5f', '\U0001da60', '\U0001da61', '\U0001da62', '\U0001da63', '\U0001da64', '\U0001da65', 
-    '\U0001da66', '\U0001da67', '\U0001da68', '\U0001da69', '\U0001da6a', '\U0001da6b', '\U0001da6c', '\U0001da75', 
-    '\U0001da84', '\U0001da9b', '\U0001da9c', '\U0001da9d', '\U0001da9e', '\U0001da9f', '\U0001daa1', '\U0001daa2', 
-    '\U0001daa3', '\U0001daa4', '\U0001daa5', '\U0001daa6', '\U0001daa7', '\U0001daa8', '\U0001daa9', '\U0001daaa', 
-    '\U0001daab', '\U0001daac', '\U0001daad', '\U0001daae', '\U0001daaf', '\U0001e000', '\U0001e001', '\U0001e002', 
-    '\U0001e003', '\U0001e004', '\U0001e005', '\U0001e006', '\U0001e008', '\U0001e009', '\U0001e00a', '\U0001e00b', 
-    '\U0001e00c', '\U0001e00d', '\U0001e00e', '\U0001e00f', '\U0001e010', '\U0001e011', '\U0001e012', '\U0001e013', 
-    '\U0001e014', '\U0001e015', '\U0001e016', '\U0001e017', '\U0001e018', '\U0001e01b', '\U0001e01c', '\U0001e01d', 
-    '\U0001e01e', '\U0001e01f', '\U0001e020', '\U0001e021', '\U0001e023', '\U0001e024', '\U0001e026', '\U0001e027', 
-    '\U0001e028', '\U0001e029', '\U0001e02a', '\U0001e8d0', '\U0001e8d1', '\U0001e8d2', '\U0001e8d3', '\U0001e8d4', 
-    '\U0001e8d5', '\U0001e8d6', '\U0001e944', '\U0001e945', '\U0001e946', '\U0001e947', '\U0001e948', '\U0001e949', 
-    '\U0001e94a', '\U0001f3fb', '\U0001f3fc', '\U0001f3fd', '\U0001f3fe', '\U0001f3ff', '\U000e0020', '\U000e0021', 
-    '\U000e0022', '\U000e0023', '\U000e0024', '\U000e0025', '\U000e0026', '\U000e0027', '\U000e0028', '\U000e0029', 
-    '\U000e002a', '\U000e002b', '\U000e002c', '\U000e002d', '\U000e002e', '\U000e002f', '\U000e0030', '\U000e0031', 
-    '\U000e0032', '\U000e0033', '\U000e0034', '\U000e0035', '\U000e0036', '\U000e0037', '\U000e0038', '\U000e0039', 
-    '\U000e003a', '\U000e003b', '\U000e003c', '\U000e003d', '\U000e003e', '\U000e003f', '\U000e0040', '\U000e0041', 
-    '\U000e0042', '\U000e0043', '\U000e0044', '\U000e0045', '\U000e0046', '\U000e0047', '\U000e0048', '\U000e0049', 
-    '\U000e004a', '\U000e004b', '\U000e004c', '\U000e004d', '\U000e004e', '\U000e004f', '\U000e0050', '\U000e0051', 
-    '\U000e0052', '\U000e0053', '\U000e0054', '\U000e0055', '\U000e0056', '\U000e0057', '\U000e0058', '\U000e0059', 
-    '\U000e005a', '\U000e005b', '\U000e005c', '\U000e005d', '\U000e005e', '\U000e005f', '\U000e0060', '\U000e0061', 
-    '\U000e0062', '\U000e0063', '\U000e0064', '\U000e0065', '\U000e0066', '\U000e0067', '\U000e0068', '\U000e0069', 
-    '\U000e006a', '\U000e006b', '\U000e006c', '\U000e006d', '\U000e006e', '\U000e006f', '\U000e0070', '\U000e0071', 
-    '\U000e0072', '\U000e0073', '\U000e0074', '\U000e0075', '\U000e0076', '\U000e0077', '\U000e0078', '\U000e0079', 
-    '\U000e007a', '\U000e007b', '\U000e007c', '\U000e007d', '\U000e007e', '\U000e007f', '\U000e0100', '\U000e0101', 
-    '\U000e0102', '\U000e0103', '\U000e0104', '\U000e0105', '\U000e0106', '\U000e0107', '\U000e0108', '\U000e0109', 
-    '\U000e010a', '\U000e010b', '\U000e010c', '\U000e010d', '\U000e010e', '\U000e010f', '\U000e0110', '\U000e0111', 
-    '\U000e0112', '\U000e0113', '\U000e0114', '\U000e0115', '\U000e0116', '\U000e0117', '\U000e0118', '\U000e0119', 
-    '\U000e011a', '\U000e011b', '\U000e011c', '\U000e011d', '\U000e011e', '\U000e011f', '\U000e0120', '\U000e0121', 
-    '\U000e0122', '\U000e0123', '\U000e0124', '\U000e0125', '\U000e0126', '\U000e0127', '\U000e0128', '\U000e0129', 
-    '\U000e012a', '\U000e012b', '\U000e012c', '\U000e012d', '\U000e012e', '\U000e012f', '\U000e0130', '\U000e0131', 
-    '\U000e0132', '\U000e0133', '\U000e0134', '\U000e0135', '\U000e0136', '\U000e0137', '\U000e0138', '\U000e0139', 
-    '\U000e013a', '\U000e013b', '\U000e013c', '\U000e013d', '\U000e013e', '\U000e013f', '\U000e0140', '\U000e0141', 
-    '\U000e0142', '\U000e0143', '\U000e0144', '\U000e0145', '\U000e0146', '\U000e0147', '\U000e0148', '\U000e0149', 
-    '\U000e014a', '\U000e014b', '\U000e014c', '\U000e014d', '\U000e014e', '\U000e014f', '\U000e0150', '\U000e0151', 
-    '\U000e0152', '\U000e0153', '\U000e0154', '\U000e0155', '\U000e0156', '\U000e0157', '\U000e0158', '\U000e0159', 
-    '\U000e015a', '\U000e015b', '\U000e015c', '\U000e015d', '\U000e015e', '\U000e015f', '\U000e0160', '\U000e0161', 
-    '\U000e0162', '\U000e0163', '\U000e0164', '\U000e0165', '\U000e0166', '\U000e0167', '\U000e0168', '\U000e0169', 
-    '\U000e016a', '\U000e016b', '\U000e016c', '\U000e016d', '\U000e016e', '\U000e016f', '\U000e0170', '\U000e0171', 
-    '\U000e0172', '\U000e0173', '\U000e0174', '\U000e0175', '\U000e0176', '\U000e0177', '\U000e0178', '\U000e0179', 
-    '\U000e017a', '\U000e017b', '\U000e017c', '\U000e017d', '\U000e017e', '\U000e017f', '\U000e0180', '\U000e0181', 
-    '\U000e0182', '\U000e0183', '\U000e0184', '\U000e0185', '\U000e0186', '\U000e0187', '\U000e0188', '\U000e0189', 
-    '\U000e018a', '\U000e018b', '\U000e018c', '\U000e018d', '\U000e018e', '\U000e018f', '\U000e0190', '\U000e0191', 
-    '\U000e0192', '\U000e0193', '\U000e0194', '\U000e0195', '\U000e0196', '\U000e0197', '\U000e0198', '\U000e0199', 
-    '\U000e019a', '\U000e019b', '\U000e019c', '\U000e019d', '\U000e019e', '\U000e019f', '\U000e01a0', '\U000e01a1', 
-    '\U000e01a2', '\U000e01a3', '\U000e01a4', '\U000e01a5', '\U000e01a6', '\U000e01a7', '\U000e01a8', '\U000e01a9', 
-    '\U000e01aa', '\U000e01ab', '\U000e01ac', '\U000e01ad', '\U000e01ae', '\U000e01af', '\U000e01b0', '\U000e01b1', 
-    '\U000e01b2', '\U000e01b3', '\U000e01b4', '\U000e01b5', '\U000e01b6', '\U000e01b7', '\U000e01b8', '\U000e01b9', 
-    '\U000e01ba', '\U000e01bb', '\U000e01bc', '\U000e01bd', '\U000e01be', '\U000e01bf', '\U000e01c0', '\U000e01c1', 
-    '\U000e01c2', '\U000e01c3', '\U000e01c4', '\U000e01c5', '\U000e01c6', '\U000e01c7', '\U000e01c8', '\U000e01c9', 
-    '\U000e01ca', '\U000e01cb', '\U000e01cc', '\U000e01cd', '\U000e01ce', '\U000e01cf', '\U000e01d0', '\U000e01d1', 
-    '\U000e01d2', '\U000e01d3', '\U000e01d4', '\U000e01d5', '\U000e01d6', '\U000e01d7', '\U000e01d8', '\U000e01d9', 
-    '\U000e01da', '\U000e01db', '\U000e01dc', '\U000e01dd', '\U000e01de', '\U000e01df', '\U000e01e0', '\U000e01e1', 
-    '\U000e01e2', '\U000e01e3', '\U000e01e4', '\U000e01e5', '\U000e01e6', '\U000e01e7', '\U000e01e8', '\U000e01e9', 
-    '\U000e01ea', '\U000e01eb', '\U000e01ec', '\U000e01ed', '\U000e01ee', '\U000e01ef')
-    rangeFromGraphemeClass[int(ExtendClass)] = Extend
-
     // Range for Grapheme class Regional_Indicator
     Regional_Indicator = rangetable.New('\U0001f1e6', '\U0001f1e7', '\U0001f1e8', '\U0001f1e9', '\U0001f1ea', '\U0001f1eb', 
     '\U0001f1ec', '\U0001f1ed', '\U0001f1ee', '\U0001f1ef', '\U0001f1f0', '\U0001f1f1', '\U0001f1f2', '\U0001f1f3', 
@@ -329,317 +75,23 @@ func setupGraphemeClasses() {
     '\U0001f1fc', '\U0001f1fd', '\U0001f1fe', '\U0001f1ff')
     rangeFromGraphemeClass[int(Regional_IndicatorClass)] = Regional_Indicator
 
-    // Range for Grapheme class L
-    L = rangetable.New('\u1100', '\u1101', '\u1102', '\u1103', '\u1104', '\u1105', 
-    '\u1106', '\u1107', '\u1108', '\u1109', '\u110a', '\u110b', '\u110c', '\u110d', 
-    '\u110e', '\u110f', '\u1110', '\u1111', '\u1112', '\u1113', '\u1114', '\u1115', 
-    '\u1116', '\u1117', '\u1118', '\u1119', '\u111a', '\u111b', '\u111c', '\u111d', 
-    '\u111e', '\u111f', '\u1120', '\u1121', '\u1122', '\u1123', '\u1124', '\u1125', 
-    '\u1126', '\u1127', '\u1128', '\u1129', '\u112a', '\u112b', '\u112c', '\u112d', 
-    '\u112e', '\u112f', '\u1130', '\u1131', '\u1132', '\u1133', '\u1134', '\u1135', 
-    '\u1136', '\u1137', '\u1138', '\u1139', '\u113a', '\u113b', '\u113c', '\u113d', 
-    '\u113e', '\u113f', '\u1140', '\u1141', '\u1142', '\u1143', '\u1144', '\u1145', 
-    '\u1146', '\u1147', '\u1148', '\u1149', '\u114a', '\u114b', '\u114c', '\u114d', 
-    '\u114e', '\u114f', '\u1150', '\u1151', '\u1152', '\u1153', '\u1154', '\u1155', 
-    '\u1156', '\u1157', '\u1158', '\u1159', '\u115a', '\u115b', '\u115c', '\u115d', 
-    '\u115e', '\u115f', '\ua960', '\ua961', '\ua962', '\ua963', '\ua964', '\ua965', 
-    '\ua966', '\ua967', '\ua968', '\ua969', '\ua96a', '\ua96b', '\ua96c', '\ua96d', 
-    '\ua96e', '\ua96f', '\ua970', '\ua971', '\ua972', '\ua973', '\ua974', '\ua975', 
-    '\ua976', '\ua977', '\ua978', '\ua979', '\ua97a', '\ua97b', '\ua97c')
-    rangeFromGraphemeClass[int(LClass)] = L
-
-    // Range for Grapheme class T
-    T = rangetable.New('\u11a8', '\u11a9', '\u11aa', '\u11ab', '\u11ac', '\u11ad', 
-    '\u11ae', '\u11af', '\u11b0', '\u11b1', '\u11b2', '\u11b3', '\u11b4', '\u11b5', 
-    '\u11b6', '\u11b7', '\u11b8', '\u11b9', '\u11ba', '\u11bb', '\u11bc', '\u11bd', 
-    '\u11be', '\u11bf', '\u11c0', '\u11c1', '\u11c2', '\u11c3', '\u11c4', '\u11c5', 
-    '\u11c6', '\u11c7', '\u11c8', '\u11c9', '\u11ca', '\u11cb', '\u11cc', '\u11cd', 
-    '\u11ce', '\u11cf', '\u11d0', '\u11d1', '\u11d2', '\u11d3', '\u11d4', '\u11d5', 
-    '\u11d6', '\u11d7', '\u11d8', '\u11d9', '\u11da', '\u11db', '\u11dc', '\u11dd', 
-    '\u11de', '\u11df', '\u11e0', '\u11e1', '\u11e2', '\u11e3', '\u11e4', '\u11e5', 
-    '\u11e6', '\u11e7', '\u11e8', '\u11e9', '\u11ea', '\u11eb', '\u11ec', '\u11ed', 
-    '\u11ee', '\u11ef', '\u11f0', '\u11f1', '\u11f2', '\u11f3', '\u11f4', '\u11f5', 
-    '\u11f6', '\u11f7', '\u11f8', '\u11f9', '\u11fa', '\u11fb', '\u11fc', '\u11fd', 
-    '\u11fe', '\u11ff', '\ud7cb', '\ud7cc', '\ud7cd', '\ud7ce', '\ud7cf', '\ud7d0', 
-    '\ud7d1', '\ud7d2', '\ud7d3', '\ud7d4', '\ud7d5', '\ud7d6', '\ud7d7', '\ud7d8', 
-    '\ud7d9', '\ud7da', '\ud7db', '\ud7dc', '\ud7dd', '\ud7de', '\ud7df', '\ud7e0', 
-    '\ud7e1', '\ud7e2', '\ud7e3', '\ud7e4', '\ud7e5', '\ud7e6', '\ud7e7', '\ud7e8', 
-    '\ud7e9', '\ud7ea', '\ud7eb', '\ud7ec', '\ud7ed', '\ud7ee', '\ud7ef', '\ud7f0', 
-    '\ud7f1', '\ud7f2', '\ud7f3', '\ud7f4', '\ud7f5', '\ud7f6', '\ud7f7', '\ud7f8', 
-    '\ud7f9', '\ud7fa', '\ud7fb')
-    rangeFromGraphemeClass[int(TClass)] = T
-
     // Range for Grapheme class Control
     Control = rangetable.New('\x00', '\x01', '\x02', '\x03', '\x04', '\x05', 
     '\x06', '\a', '\b', '\t', '\v', '\f', '\x0e', '\x0f', 
     '\x10', '\x11', '\x12', '\x13', '\x14', '\x15', '\x16', '\x17', 
     '\x18', '\x19', '\x1a', '\x1b', '\x1c', '\x1d', '\x1e', '\x1f', 
-    '\u007f', '\u0080', '\u0081', '\u0082', '\u0083', '\u0084', '\u0085', '\u0086', 
+    '\x7f', '\u0080', '\u0081', '\u0082', '\u0083', '\u0084', '\u0085', '\u0086', 
     '\u0087', '\u0088', '\u0089', '\u008a', '\u008b', '\u008c', '\u008d', '\u008e', 
     '\u008f', '\u0090', '\u0091', '\u0092', '\u0093', '\u0094', '\u0095', '\u0096', 
     '\u0097', '\u0098', '\u0099', '\u009a', '\u009b', '\u009c', '\u009d', '\u009e', 
     '\u009f', '\u00ad', '\u061c', '\u180e', '\u200b', '\u200e', '\u200f', '\u2028', 
     '\u2029', '\u202a', '\u202b', '\u202c', '\u202d', '\u202e', '\u2060', '\u2061', 
     '\u2062', '\u2063', '\u2064', '\u2065', '\u2066', '\u2067', '\u2068', '\u2069', 
-    '\u206a', '\u206b', '\u206c', '\u206d', '\u206e', '\u206f', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', 
-    '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufffd', '\ufeff', '\ufff0', 
+    '\u206a', '\u206b', '\u206c', '\u206d', '\u206e', '\u206f', '\ufeff', '\ufff0', 
     '\ufff1', '\ufff2', '\ufff3', '\ufff4', '\ufff5', '\ufff6', '\ufff7', '\ufff8', 
-    '\ufff9', '\ufffa', '\ufffb', '\U0001bca0', '\U0001bca1', '\U0001bca2', '\U0001bca3', '\U0001d173', 
+    '\ufff9', '\ufffa', '\ufffb', '\U00013430', '\U00013431', '\U00013432', '\U00013433', '\U00013434', 
+    '\U00013435', '\U00013436', '\U00013437', '\U00013438', '\U00013439', '\U0001343a', '\U0001343b', '\U0001343c', 
+    '\U0001343d', '\U0001343e', '\U0001343f', '\U0001bca0', '\U0001bca1', '\U0001bca2', '\U0001bca3', '\U0001d173', 
     '\U0001d174', '\U0001d175', '\U0001d176', '\U0001d177', '\U0001d178', '\U0001d179', '\U0001d17a', '\U000e0000', 
     '\U000e0001', '\U000e0002', '\U000e0003', '\U000e0004', '\U000e0005', '\U000e0006', '\U000e0007', '\U000e0008', 
     '\U000e0009', '\U000e000a', '\U000e000b', '\U000e000c', '\U000e000d', '\U000e000e', '\U000e000f', '\U000e0010', 
@@ -1121,111 +573,49 @@ func setupGraphemeClasses() {
     '\u0b02', '\u0b03', '\u0b40', '\u0b47', '\u0b48', '\u0b4b', '\u0b4c', '\u0bbf', 
     '\u0bc1', '\u0bc2', '\u0bc6', '\u0bc7', '\u0bc8', '\u0bca', '\u0bcb', '\u0bcc', 
     '\u0c01', '\u0c02', '\u0c03', '\u0c41', '\u0c42', '\u0c43', '\u0c44', '\u0c82', 
-    '\u0c83', '\u0cbe', '\u0cc0', '\u0cc1', '\u0cc3', '\u0cc4', '\u0cc7', '\u0cc8', 
-    '\u0cca', '\u0ccb', '\u0d02', '\u0d03', '\u0d3f', '\u0d40', '\u0d46', '\u0d47', 
-    '\u0d48', '\u0d4a', '\u0d4b', '\u0d4c', '\u0d82', '\u0d83', '\u0dd0', '\u0dd1', 
-    '\u0dd8', '\u0dd9', '\u0dda', '\u0ddb', '\u0ddc', '\u0ddd', '\u0dde', '\u0df2', 
-    '\u0df3', '\u0e33', '\u0eb3', '\u0f3e', '\u0f3f', '\u0f7f', '\u1031', '\u103b', 
-    '\u103c', '\u1056', '\u1057', '\u1084', '\u17b6', '\u17be', '\u17bf', '\u17c0', 
-    '\u17c1', '\u17c2', '\u17c3', '\u17c4', '\u17c5', '\u17c7', '\u17c8', '\u1923', 
-    '\u1924', '\u1925', '\u1926', '\u1929', '\u192a', '\u192b', '\u1930', '\u1931', 
-    '\u1933', '\u1934', '\u1935', '\u1936', '\u1937', '\u1938', '\u1a19', '\u1a1a', 
-    '\u1a55', '\u1a57', '\u1a6d', '\u1a6e', '\u1a6f', '\u1a70', '\u1a71', '\u1a72', 
-    '\u1b04', '\u1b35', '\u1b3b', '\u1b3d', '\u1b3e', '\u1b3f', '\u1b40', '\u1b41', 
-    '\u1b43', '\u1b44', '\u1b82', '\u1ba1', '\u1ba6', '\u1ba7', '\u1baa', '\u1be7', 
-    '\u1bea', '\u1beb', '\u1bec', '\u1bee', '\u1bf2', '\u1bf3', '\u1c24', '\u1c25', 
-    '\u1c26', '\u1c27', '\u1c28', '\u1c29', '\u1c2a', '\u1c2b', '\u1c34', '\u1c35', 
-    '\u1ce1', '\u1cf2', '\u1cf3', '\u1cf7', '\ua823', '\ua824', '\ua827', '\ua880', 
-    '\ua881', '\ua8b4', '\ua8b5', '\ua8b6', '\ua8b7', '\ua8b8', '\ua8b9', '\ua8ba', 
-    '\ua8bb', '\ua8bc', '\ua8bd', '\ua8be', '\ua8bf', '\ua8c0', '\ua8c1', '\ua8c2', 
-    '\ua8c3', '\ua952', '\ua953', '\ua983', '\ua9b4', '\ua9b5', '\ua9ba', '\ua9bb', 
-    '\ua9bd', '\ua9be', '\ua9bf', '\ua9c0', '\uaa2f', '\uaa30', '\uaa33', '\uaa34', 
-    '\uaa4d', '\uaaeb', '\uaaee', '\uaaef', '\uaaf5', '\uabe3', '\uabe4', '\uabe6', 
-    '\uabe7', '\uabe9', '\uabea', '\uabec', '\U00011000', '\U00011002', '\U00011082', '\U000110b0', 
-    '\U000110b1', '\U000110b2', '\U000110b7', '\U000110b8', '\U0001112c', '\U00011145', '\U00011146', '\U00011182', 
-    '\U000111b3', '\U000111b4', '\U000111b5', '\U000111bf', '\U000111c0', '\U0001122c', '\U0001122d', '\U0001122e', 
-    '\U00011232', '\U00011233', '\U00011235', '\U000112e0', '\U000112e1', '\U000112e2', '\U00011302', '\U00011303', 
-    '\U0001133f', '\U00011341', '\U00011342', '\U00011343', '\U00011344', '\U00011347', '\U00011348', '\U0001134b', 
-    '\U0001134c', '\U0001134d', '\U00011362', '\U00011363', '\U00011435', '\U00011436', '\U00011437', '\U00011440', 
-    '\U00011441', '\U00011445', '\U000114b1', '\U000114b2', '\U000114b9', '\U000114bb', '\U000114bc', '\U000114be', 
-    '\U000114c1', '\U000115b0', '\U000115b1', '\U000115b8', '\U000115b9', '\U000115ba', '\U000115bb', '\U000115be', 
-    '\U00011630', '\U00011631', '\U00011632', '\U0001163b', '\U0001163c', '\U0001163e', '\U000116ac', '\U000116ae', 
-    '\U000116af', '\U000116b6', '\U00011720', '\U00011721', '\U00011726', '\U0001182c', '\U0001182d', '\U0001182e', 
-    '\U00011838', '\U00011a39', '\U00011a57', '\U00011a58', '\U00011a97', '\U00011c2f', '\U00011c3e', '\U00011ca9', 
-    '\U00011cb1', '\U00011cb4', '\U00011d8a', '\U00011d8b', '\U00011d8c', '\U00011d8d', '\U00011d8e', '\U00011d93', 
-    '\U00011d94', '\U00011d96', '\U00011ef5', '\U00011ef6', '\U00016f51', '\U00016f52', '\U00016f53', '\U00016f54', 
-    '\U00016f55', '\U00016f56', '\U00016f57', '\U00016f58', '\U00016f59', '\U00016f5a', '\U00016f5b', '\U00016f5c', 
-    '\U00016f5d', '\U00016f5e', '\U00016f5f', '\U00016f60', '\U00016f61', '\U00016f62', '\U00016f63', '\U00016f64', 
-    '\U00016f65', '\U00016f66', '\U00016f67', '\U00016f68', '\U00016f69', '\U00016f6a', '\U00016f6b', '\U00016f6c', 
-    '\U00016f6d', '\U00016f6e', '\U00016f6f', '\U00016f70', '\U00016f71', '\U00016f72', '\U00016f73', '\U00016f74', 
-    '\U00016f75', '\U00016f76', '\U00016f77', '\U00016f78', '\U00016f79', '\U00016f7a', '\U00016f7b', '\U00016f7c', 
-    '\U00016f7d', '\U00016f7e', '\U0001d166', '\U0001d16d')
+    '\u0c83', '\u0cbe', '\u0cc1', '\u0cc3', '\u0cc4', '\u0cf3', '\u0d02', '\u0d03', 
+    '\u0d3f', '\u0d40', '\u0d46', '\u0d47', '\u0d48', '\u0d4a', '\u0d4b', '\u0d4c', 
+    '\u0d82', '\u0d83', '\u0dd0', '\u0dd1', '\u0dd8', '\u0dd9', '\u0dda', '\u0ddb', 
+    '\u0ddc', '\u0ddd', '\u0dde', '\u0df2', '\u0df3', '\u0e33', '\u0eb3', '\u0f3e', 
+    '\u0f3f', '\u0f7f', '\u1031', '\u103b', '\u103c', '\u1056', '\u1057', '\u1084', 
+    '\u17b6', '\u17be', '\u17bf', '\u17c0', '\u17c1', '\u17c2', '\u17c3', '\u17c4', 
+    '\u17c5', '\u17c7', '\u17c8', '\u1923', '\u1924', '\u1925', '\u1926', '\u1929', 
+    '\u192a', '\u192b', '\u1930', '\u1931', '\u1933', '\u1934', '\u1935', '\u1936', 
+    '\u1937', '\u1938', '\u1a19', '\u1a1a', '\u1a55', '\u1a57', '\u1a6d', '\u1a6e', 
+    '\u1a6f', '\u1a70', '\u1a71', '\u1a72', '\u1b04', '\u1b3e', '\u1b3f', '\u1b40', 
+    '\u1b41', '\u1b82', '\u1ba1', '\u1ba6', '\u1ba7', '\u1be7', '\u1bea', '\u1beb', 
+    '\u1bec', '\u1bee', '\u1c24', '\u1c25', '\u1c26', '\u1c27', '\u1c28', '\u1c29', 
+    '\u1c2a', '\u1c2b', '\u1c34', '\u1c35', '\u1ce1', '\u1cf7', '\ua823', '\ua824', 
+    '\ua827', '\ua880', '\ua881', '\ua8b4', '\ua8b5', '\ua8b6', '\ua8b7', '\ua8b8', 
+    '\ua8b9', '\ua8ba', '\ua8bb', '\ua8bc', '\ua8bd', '\ua8be', '\ua8bf', '\ua8c0', 
+    '\ua8c1', '\ua8c2', '\ua8c3', '\ua952', '\ua983', '\ua9b4', '\ua9b5', '\ua9ba', 
+    '\ua9bb', '\ua9be', '\ua9bf', '\uaa2f', '\uaa30', '\uaa33', '\uaa34', '\uaa4d', 
+    '\uaaeb', '\uaaee', '\uaaef', '\uaaf5', '\uabe3', '\uabe4', '\uabe6', '\uabe7', 
+    '\uabe9', '\uabea', '\uabec', '\U00011000', '\U00011002', '\U00011082', '\U000110b0', '\U000110b1', 
+    '\U000110b2', '\U000110b7', '\U000110b8', '\U0001112c', '\U00011145', '\U00011146', '\U00011182', '\U000111b3', 
+    '\U000111b4', '\U000111b5', '\U000111bf', '\U000111ce', '\U0001122c', '\U0001122d', '\U0001122e', '\U00011232', 
+    '\U00011233', '\U000112e0', '\U000112e1', '\U000112e2', '\U00011302', '\U00011303', '\U0001133f', '\U00011341', 
+    '\U00011342', '\U00011343', '\U00011344', '\U00011347', '\U00011348', '\U0001134b', '\U0001134c', '\U00011362', 
+    '\U00011363', '\U000113b9', '\U000113ba', '\U000113ca', '\U000113cc', '\U000113cd', '\U00011435', '\U00011436', 
+    '\U00011437', '\U00011440', '\U00011441', '\U00011445', '\U000114b1', '\U000114b2', '\U000114b9', '\U000114bb', 
+    '\U000114bc', '\U000114be', '\U000114c1', '\U000115b0', '\U000115b1', '\U000115b8', '\U000115b9', '\U000115ba', 
+    '\U000115bb', '\U000115be', '\U00011630', '\U00011631', '\U00011632', '\U0001163b', '\U0001163c', '\U0001163e', 
+    '\U000116ac', '\U000116ae', '\U000116af', '\U0001171e', '\U00011726', '\U0001182c', '\U0001182d', '\U0001182e', 
+    '\U00011838', '\U00011931', '\U00011932', '\U00011933', '\U00011934', '\U00011935', '\U00011937', '\U00011938', 
+    '\U00011940', '\U00011942', '\U000119d1', '\U000119d2', '\U000119d3', '\U000119dc', '\U000119dd', '\U000119de', 
+    '\U000119df', '\U000119e4', '\U00011a39', '\U00011a57', '\U00011a58', '\U00011a97', '\U00011b61', '\U00011b65', 
+    '\U00011b67', '\U00011c2f', '\U00011c3e', '\U00011ca9', '\U00011cb1', '\U00011cb4', '\U00011d8a', '\U00011d8b', 
+    '\U00011d8c', '\U00011d8d', '\U00011d8e', '\U00011d93', '\U00011d94', '\U00011d96', '\U00011ef5', '\U00011ef6', 
+    '\U00011f03', '\U00011f34', '\U00011f35', '\U00011f3e', '\U00011f3f', '\U0001612a', '\U0001612b', '\U0001612c', 
+    '\U00016f51', '\U00016f52', '\U00016f53', '\U00016f54', '\U00016f55', '\U00016f56', '\U00016f57', '\U00016f58', 
+    '\U00016f59', '\U00016f5a', '\U00016f5b', '\U00016f5c', '\U00016f5d', '\U00016f5e', '\U00016f5f', '\U00016f60', 
+    '\U00016f61', '\U00016f62', '\U00016f63', '\U00016f64', '\U00016f65', '\U00016f66', '\U00016f67', '\U00016f68', 
+    '\U00016f69', '\U00016f6a', '\U00016f6b', '\U00016f6c', '\U00016f6d', '\U00016f6e', '\U00016f6f', '\U00016f70', 
+    '\U00016f71', '\U00016f72', '\U00016f73', '\U00016f74', '\U00016f75', '\U00016f76', '\U00016f77', '\U00016f78', 
+    '\U00016f79', '\U00016f7a', '\U00016f7b', '\U00016f7c', '\U00016f7d', '\U00016f7e', '\U00016f7f', '\U00016f80', 
+    '\U00016f81', '\U00016f82', '\U00016f83', '\U00016f84', '\U00016f85', '\U00016f86', '\U00016f87')
     rangeFromGraphemeClass[int(SpacingMarkClass)] = SpacingMark
 
-    // Range for Grapheme class LV
-    LV = rangetable.New('\uac00', '\uac1c', '\uac38', '\uac54', '\uac70', '\uac8c', 
-    '\uaca8', '\uacc4', '\uace0', '\uacfc', '\uad18', '\uad34', '\uad50', '\uad6c', 
-    '\uad88', '\uada4', '\uadc0', '\uaddc', '\uadf8', '\uae14', '\uae30', '\uae4c', 
-    '\uae68', '\uae84', '\uaea0', '\uaebc', '\uaed8', '\uaef4', '\uaf10', '\uaf2c', 
-    '\uaf48', '\uaf64', '\uaf80', '\uaf9c', '\uafb8', '\uafd4', '\uaff0', '\ub00c', 
-    '\ub028', '\ub044', '\ub060', '\ub07c', '\ub098', '\ub0b4', '\ub0d0', '\ub0ec', 
-    '\ub108', '\ub124', '\ub140', '\ub15c', '\ub178', '\ub194', '\ub1b0', '\ub1cc', 
-    '\ub1e8', '\ub204', '\ub220', '\ub23c', '\ub258', '\ub274', '\ub290', '\ub2ac', 
-    '\ub2c8', '\ub2e4', '\ub300', '\ub31c', '\ub338', '\ub354', '\ub370', '\ub38c', 
-    '\ub3a8', '\ub3c4', '\ub3e0', '\ub3fc', '\ub418', '\ub434', '\ub450', '\ub46c', 
-    '\ub488', '\ub4a4', '\ub4c0', '\ub4dc', '\ub4f8', '\ub514', '\ub530', '\ub54c', 
-    '\ub568', '\ub584', '\ub5a0', '\ub5bc', '\ub5d8', '\ub5f4', '\ub610', '\ub62c', 
-    '\ub648', '\ub664', '\ub680', '\ub69c', '\ub6b8', '\ub6d4', '\ub6f0', '\ub70c', 
-    '\ub728', '\ub744', '\ub760', '\ub77c', '\ub798', '\ub7b4', '\ub7d0', '\ub7ec', 
-    '\ub808', '\ub824', '\ub840', '\ub85c', '\ub878', '\ub894', '\ub8b0', '\ub8cc', 
-    '\ub8e8', '\ub904', '\ub920', '\ub93c', '\ub958', '\ub974', '\ub990', '\ub9ac', 
-    '\ub9c8', '\ub9e4', '\uba00', '\uba1c', '\uba38', '\uba54', '\uba70', '\uba8c', 
-    '\ubaa8', '\ubac4', '\ubae0', '\ubafc', '\ubb18', '\ubb34', '\ubb50', '\ubb6c', 
-    '\ubb88', '\ubba4', '\ubbc0', '\ubbdc', '\ubbf8', '\ubc14', '\ubc30', '\ubc4c', 
-    '\ubc68', '\ubc84', '\ubca0', '\ubcbc', '\ubcd8', '\ubcf4', '\ubd10', '\ubd2c', 
-    '\ubd48', '\ubd64', '\ubd80', '\ubd9c', '\ubdb8', '\ubdd4', '\ubdf0', '\ube0c', 
-    '\ube28', '\ube44', '\ube60', '\ube7c', '\ube98', '\ubeb4', '\ubed0', '\ubeec', 
-    '\ubf08', '\ubf24', '\ubf40', '\ubf5c', '\ubf78', '\ubf94', '\ubfb0', '\ubfcc', 
-    '\ubfe8', '\uc004', '\uc020', '\uc03c', '\uc058', '\uc074', '\uc090', '\uc0ac', 
-    '\uc0c8', '\uc0e4', '\uc100', '\uc11c', '\uc138', '\uc154', '\uc170', '\uc18c', 
-    '\uc1a8', '\uc1c4', '\uc1e0', '\uc1fc', '\uc218', '\uc234', '\uc250', '\uc26c', 
-    '\uc288', '\uc2a4', '\uc2c0', '\uc2dc', '\uc2f8', '\uc314', '\uc330', '\uc34c', 
-    '\uc368', '\uc384', '\uc3a0', '\uc3bc', '\uc3d8', '\uc3f4', '\uc410', '\uc42c', 
-    '\uc448', '\uc464', '\uc480', '\uc49c', '\uc4b8', '\uc4d4', '\uc4f0', '\uc50c', 
-    '\uc528', '\uc544', '\uc560', '\uc57c', '\uc598', '\uc5b4', '\uc5d0', '\uc5ec', 
-    '\uc608', '\uc624', '\uc640', '\uc65c', '\uc678', '\uc694', '\uc6b0', '\uc6cc', 
-    '\uc6e8', '\uc704', '\uc720', '\uc73c', '\uc758', '\uc774', '\uc790', '\uc7ac', 
-    '\uc7c8', '\uc7e4', '\uc800', '\uc81c', '\uc838', '\uc854', '\uc870', '\uc88c', 
-    '\uc8a8', '\uc8c4', '\uc8e0', '\uc8fc', '\uc918', '\uc934', '\uc950', '\uc96c', 
-    '\uc988', '\uc9a4', '\uc9c0', '\uc9dc', '\uc9f8', '\uca14', '\uca30', '\uca4c', 
-    '\uca68', '\uca84', '\ucaa0', '\ucabc', '\ucad8', '\ucaf4', '\ucb10', '\ucb2c', 
-    '\ucb48', '\ucb64', '\ucb80', '\ucb9c', '\ucbb8', '\ucbd4', '\ucbf0', '\ucc0c', 
-    '\ucc28', '\ucc44', '\ucc60', '\ucc7c', '\ucc98', '\uccb4', '\uccd0', '\uccec', 
-    '\ucd08', '\ucd24', '\ucd40', '\ucd5c', '\ucd78', '\ucd94', '\ucdb0', '\ucdcc', 
-    '\ucde8', '\uce04', '\uce20', '\uce3c', '\uce58', '\uce74', '\uce90', '\uceac', 
-    '\ucec8', '\ucee4', '\ucf00', '\ucf1c', '\ucf38', '\ucf54', '\ucf70', '\ucf8c', 
-    '\ucfa8', '\ucfc4', '\ucfe0', '\ucffc', '\ud018', '\ud034', '\ud050', '\ud06c', 
-    '\ud088', '\ud0a4', '\ud0c0', '\ud0dc', '\ud0f8', '\ud114', '\ud130', '\ud14c', 
-    '\ud168', '\ud184', '\ud1a0', '\ud1bc', '\ud1d8', '\ud1f4', '\ud210', '\ud22c', 
-    '\ud248', '\ud264', '\ud280', '\ud29c', '\ud2b8', '\ud2d4', '\ud2f0', '\ud30c', 
-    '\ud328', '\ud344', '\ud360', '\ud37c', '\ud398', '\ud3b4', '\ud3d0', '\ud3ec', 
-    '\ud408', '\ud424', '\ud440', '\ud45c', '\ud478', '\ud494', '\ud4b0', '\ud4cc', 
-    '\ud4e8', '\ud504', '\ud520', '\ud53c', '\ud558', '\ud574', '\ud590', '\ud5ac', 
-    '\ud5c8', '\ud5e4', '\ud600', '\ud61c', '\ud638', '\ud654', '\ud670', '\ud68c', 
-    '\ud6a8', '\ud6c4', '\ud6e0', '\ud6fc', '\ud718', '\ud734', '\ud750', '\ud76c', 
-    '\ud788')
-    rangeFromGraphemeClass[int(LVClass)] = LV
-
-    // Range for Grapheme class ZWJ
-    ZWJ = rangetable.New('\u200d')
-    rangeFromGraphemeClass[int(ZWJClass)] = ZWJ
-
-    // Range for Grapheme class Prepend
-    Prepend = rangetable.New('\u0600', '\u0601', '\u0602', '\u0603', '\u0604', '\u0605', 
-    '\u06dd', '\u070f', '\u08e2', '\u0d4e', '\U000110bd', '\U000110cd', '\U000111c2', '\U000111c3', 
-    '\U00011a3a', '\U00011a86', '\U00011a87', '\U00011a88', '\U00011a89', '\U00011d46')
-    rangeFromGraphemeClass[int(PrependClass)] = Prepend
-
     // Range for Grapheme class V
     V = rangetable.New('\u1160', '\u1161', '\u1162', '\u1163', '\u1164', '\u1165', 
     '\u1166', '\u1167', '\u1168', '\u1169', '\u116a', '\u116b', '\u116c', '\u116d', 
@@ -1239,7 +629,7 @@ func setupGraphemeClasses() {
     '\u11a6', '\u11a7', '\ud7b0', '\ud7b1', '\ud7b2', '\ud7b3', '\ud7b4', '\ud7b5', 
     '\ud7b6', '\ud7b7', '\ud7b8', '\ud7b9', '\ud7ba', '\ud7bb', '\ud7bc', '\ud7bd', 
     '\ud7be', '\ud7bf', '\ud7c0', '\ud7c1', '\ud7c2', '\ud7c3', '\ud7c4', '\ud7c5', 
-    '\ud7c6')
+    '\ud7c6', '\U00016d63', '\U00016d67', '\U00016d68', '\U00016d69', '\U00016d6a')
     rangeFromGraphemeClass[int(VClass)] = V
 
     // Range for Grapheme class LVT
@@ -2591,4 +1981,1394 @@ func setupGraphemeClasses() {
     '\ud795', '\ud796', '\ud797', '\ud798', '\ud799', '\ud79a', '\ud79b', '\ud79c', 
     '\ud79d', '\ud79e', '\ud79f', '\ud7a0', '\ud7a1', '\ud7a2', '\ud7a3')
     rangeFromGraphemeClass[int(LVTClass)] = LVT
+
+    // Range for Grapheme class LF
+    LF = rangetable.New('\n')
+    rangeFromGraphemeClass[int(LFClass)] = LF
+
+    // Range for Grapheme class LV
+    LV = rangetable.New('\uac00', '\uac1c', '\uac38', '\uac54', '\uac70', '\uac8c', 
+    '\uaca8', '\uacc4', '\uace0', '\uacfc', '\uad18', '\uad34', '\uad50', '\uad6c', 
+    '\uad88', '\uada4', '\uadc0', '\uaddc', '\uadf8', '\uae14', '\uae30', '\uae4c', 
+    '\uae68', '\uae84', '\uaea0', '\uaebc', '\uaed8', '\uaef4', '\uaf10', '\uaf2c', 
+    '\uaf48', '\uaf64', '\uaf80', '\uaf9c', '\uafb8', '\uafd4', '\uaff0', '\ub00c', 
+    '\ub028', '\ub044', '\ub060', '\ub07c', '\ub098', '\ub0b4', '\ub0d0', '\ub0ec', 
+    '\ub108', '\ub124', '\ub140', '\ub15c', '\ub178', '\ub194', '\ub1b0', '\ub1cc', 
+    '\ub1e8', '\ub204', '\ub220', '\ub23c', '\ub258', '\ub274', '\ub290', '\ub2ac', 
+    '\ub2c8', '\ub2e4', '\ub300', '\ub31c', '\ub338', '\ub354', '\ub370', '\ub38c', 
+    '\ub3a8', '\ub3c4', '\ub3e0', '\ub3fc', '\ub418', '\ub434', '\ub450', '\ub46c', 
+    '\ub488', '\ub4a4', '\ub4c0', '\ub4dc', '\ub4f8', '\ub514', '\ub530', '\ub54c', 
+    '\ub568', '\ub584', '\ub5a0', '\ub5bc', '\ub5d8', '\ub5f4', '\ub610', '\ub62c', 
+    '\ub648', '\ub664', '\ub680', '\ub69c', '\ub6b8', '\ub6d4', '\ub6f0', '\ub70c', 
+    '\ub728', '\ub744', '\ub760', '\ub77c', '\ub798', '\ub7b4', '\ub7d0', '\ub7ec', 
+    '\ub808', '\ub824', '\ub840', '\ub85c', '\ub878', '\ub894', '\ub8b0', '\ub8cc', 
+    '\ub8e8', '\ub904', '\ub920', '\ub93c', '\ub958', '\ub974', '\ub990', '\ub9ac', 
+    '\ub9c8', '\ub9e4', '\uba00', '\uba1c', '\uba38', '\uba54', '\uba70', '\uba8c', 
+    '\ubaa8', '\ubac4', '\ubae0', '\ubafc', '\ubb18', '\ubb34', '\ubb50', '\ubb6c', 
+    '\ubb88', '\ubba4', '\ubbc0', '\ubbdc', '\ubbf8', '\ubc14', '\ubc30', '\ubc4c', 
+    '\ubc68', '\ubc84', '\ubca0', '\ubcbc', '\ubcd8', '\ubcf4', '\ubd10', '\ubd2c', 
+    '\ubd48', '\ubd64', '\ubd80', '\ubd9c', '\ubdb8', '\ubdd4', '\ubdf0', '\ube0c', 
+    '\ube28', '\ube44', '\ube60', '\ube7c', '\ube98', '\ubeb4', '\ubed0', '\ubeec', 
+    '\ubf08', '\ubf24', '\ubf40', '\ubf5c', '\ubf78', '\ubf94', '\ubfb0', '\ubfcc', 
+    '\ubfe8', '\uc004', '\uc020', '\uc03c', '\uc058', '\uc074', '\uc090', '\uc0ac', 
+    '\uc0c8', '\uc0e4', '\uc100', '\uc11c', '\uc138', '\uc154', '\uc170', '\uc18c', 
+    '\uc1a8', '\uc1c4', '\uc1e0', '\uc1fc', '\uc218', '\uc234', '\uc250', '\uc26c', 
+    '\uc288', '\uc2a4', '\uc2c0', '\uc2dc', '\uc2f8', '\uc314', '\uc330', '\uc34c', 
+    '\uc368', '\uc384', '\uc3a0', '\uc3bc', '\uc3d8', '\uc3f4', '\uc410', '\uc42c', 
+    '\uc448', '\uc464', '\uc480', '\uc49c', '\uc4b8', '\uc4d4', '\uc4f0', '\uc50c', 
+    '\uc528', '\uc544', '\uc560', '\uc57c', '\uc598', '\uc5b4', '\uc5d0', '\uc5ec', 
+    '\uc608', '\uc624', '\uc640', '\uc65c', '\uc678', '\uc694', '\uc6b0', '\uc6cc', 
+    '\uc6e8', '\uc704', '\uc720', '\uc73c', '\uc758', '\uc774', '\uc790', '\uc7ac', 
+    '\uc7c8', '\uc7e4', '\uc800', '\uc81c', '\uc838', '\uc854', '\uc870', '\uc88c', 
+    '\uc8a8', '\uc8c4', '\uc8e0', '\uc8fc', '\uc918', '\uc934', '\uc950', '\uc96c', 
+    '\uc988', '\uc9a4', '\uc9c0', '\uc9dc', '\uc9f8', '\uca14', '\uca30', '\uca4c', 
+    '\uca68', '\uca84', '\ucaa0', '\ucabc', '\ucad8', '\ucaf4', '\ucb10', '\ucb2c', 
+    '\ucb48', '\ucb64', '\ucb80', '\ucb9c', '\ucbb8', '\ucbd4', '\ucbf0', '\ucc0c', 
+    '\ucc28', '\ucc44', '\ucc60', '\ucc7c', '\ucc98', '\uccb4', '\uccd0', '\uccec', 
+    '\ucd08', '\ucd24', '\ucd40', '\ucd5c', '\ucd78', '\ucd94', '\ucdb0', '\ucdcc', 
+    '\ucde8', '\uce04', '\uce20', '\uce3c', '\uce58', '\uce74', '\uce90', '\uceac', 
+    '\ucec8', '\ucee4', '\ucf00', '\ucf1c', '\ucf38', '\ucf54', '\ucf70', '\ucf8c', 
+    '\ucfa8', '\ucfc4', '\ucfe0', '\ucffc', '\ud018', '\ud034', '\ud050', '\ud06c', 
+    '\ud088', '\ud0a4', '\ud0c0', '\ud0dc', '\ud0f8', '\ud114', '\ud130', '\ud14c', 
+    '\ud168', '\ud184', '\ud1a0', '\ud1bc', '\ud1d8', '\ud1f4', '\ud210', '\ud22c', 
+    '\ud248', '\ud264', '\ud280', '\ud29c', '\ud2b8', '\ud2d4', '\ud2f0', '\ud30c', 
+    '\ud328', '\ud344', '\ud360', '\ud37c', '\ud398', '\ud3b4', '\ud3d0', '\ud3ec', 
+    '\ud408', '\ud424', '\ud440', '\ud45c', '\ud478', '\ud494', '\ud4b0', '\ud4cc', 
+    '\ud4e8', '\ud504', '\ud520', '\ud53c', '\ud558', '\ud574', '\ud590', '\ud5ac', 
+    '\ud5c8', '\ud5e4', '\ud600', '\ud61c', '\ud638', '\ud654', '\ud670', '\ud68c', 
+    '\ud6a8', '\ud6c4', '\ud6e0', '\ud6fc', '\ud718', '\ud734', '\ud750', '\ud76c', 
+    '\ud788')
+    rangeFromGraphemeClass[int(LVClass)] = LV
+
+    // Range for Grapheme class CR
+    CR = rangetable.New('\r')
+    rangeFromGraphemeClass[int(CRClass)] = CR
+
+    // Range for Grapheme class Extend
+    Extend = rangetable.New('\u0300', '\u0301', '\u0302', '\u0303', '\u0304', '\u0305', 
+    '\u0306', '\u0307', '\u0308', '\u0309', '\u030a', '\u030b', '\u030c', '\u030d', 
+    '\u030e', '\u030f', '\u0310', '\u0311', '\u0312', '\u0313', '\u0314', '\u0315', 
+    '\u0316', '\u0317', '\u0318', '\u0319', '\u031a', '\u031b', '\u031c', '\u031d', 
+    '\u031e', '\u031f', '\u0320', '\u0321', '\u0322', '\u0323', '\u0324', '\u0325', 
+    '\u0326', '\u0327', '\u0328', '\u0329', '\u032a', '\u032b', '\u032c', '\u032d', 
+    '\u032e', '\u032f', '\u0330', '\u0331', '\u0332', '\u0333', '\u0334', '\u0335', 
+    '\u0336', '\u0337', '\u0338', '\u0339', '\u033a', '\u033b', '\u033c', '\u033d', 
+    '\u033e', '\u033f', '\u0340', '\u0341', '\u0342', '\u0343', '\u0344', '\u0345', 
+    '\u0346', '\u0347', '\u0348', '\u0349', '\u034a', '\u034b', '\u034c', '\u034d', 
+    '\u034e', '\u034f', '\u0350', '\u0351', '\u0352', '\u0353', '\u0354', '\u0355', 
+    '\u0356', '\u0357', '\u0358', '\u0359', '\u035a', '\u035b', '\u035c', '\u035d', 
+    '\u035e', '\u035f', '\u0360', '\u0361', '\u0362', '\u0363', '\u0364', '\u0365', 
+    '\u0366', '\u0367', '\u0368', '\u0369', '\u036a', '\u036b', '\u036c', '\u036d', 
+    '\u036e', '\u036f', '\u0483', '\u0484', '\u0485', '\u0486', '\u0487', '\u0488', 
+    '\u0489', '\u0591', '\u0592', '\u0593', '\u0594', '\u0595', '\u0596', '\u0597', 
+    '\u0598', '\u0599', '\u059a', '\u059b', '\u059c', '\u059d', '\u059e', '\u059f', 
+    '\u05a0', '\u05a1', '\u05a2', '\u05a3', '\u05a4', '\u05a5', '\u05a6', '\u05a7', 
+    '\u05a8', '\u05a9', '\u05aa', '\u05ab', '\u05ac', '\u05ad', '\u05ae', '\u05af', 
+    '\u05b0', '\u05b1', '\u05b2', '\u05b3', '\u05b4', '\u05b5', '\u05b6', '\u05b7', 
+    '\u05b8', '\u05b9', '\u05ba', '\u05bb', '\u05bc', '\u05bd', '\u05bf', '\u05c1', 
+    '\u05c2', '\u05c4', '\u05c5', '\u05c7', '\u0610', '\u0611', '\u0612', '\u0613', 
+    '\u0614', '\u0615', '\u0616', '\u0617', '\u0618', '\u0619', '\u061a', '\u064b', 
+    '\u064c', '\u064d', '\u064e', '\u064f', '\u0650', '\u0651', '\u0652', '\u0653', 
+    '\u0654', '\u0655', '\u0656', '\u0657', '\u0658', '\u0659', '\u065a', '\u065b', 
+    '\u065c', '\u065d', '\u065e', '\u065f', '\u0670', '\u06d6', '\u06d7', '\u06d8', 
+    '\u06d9', '\u06da', '\u06db', '\u06dc', '\u06df', '\u06e0', '\u06e1', '\u06e2', 
+    '\u06e3', '\u06e4', '\u06e7', '\u06e8', '\u06ea', '\u06eb', '\u06ec', '\u06ed', 
+    '\u0711', '\u0730', '\u0731', '\u0732', '\u0733', '\u0734', '\u0735', '\u0736', 
+    '\u0737', '\u0738', '\u0739', '\u073a', '\u073b', '\u073c', '\u073d', '\u073e', 
+    '\u073f', '\u0740', '\u0741', '\u0742', '\u0743', '\u0744', '\u0745', '\u0746', 
+    '\u0747', '\u0748', '\u0749', '\u074a', '\u07a6', '\u07a7', '\u07a8', '\u07a9', 
+    '\u07aa', '\u07ab', '\u07ac', '\u07ad', '\u07ae', '\u07af', '\u07b0', '\u07eb', 
+    '\u07ec', '\u07ed', '\u07ee', '\u07ef', '\u07f0', '\u07f1', '\u07f2', '\u07f3', 
+    '\u07fd', '\u0816', '\u0817', '\u0818', '\u0819', '\u081b', '\u081c', '\u081d', 
+    '\u081e', '\u081f', '\u0820', '\u0821', '\u0822', '\u0823', '\u0825', '\u0826', 
+    '\u0827', '\u0829', '\u082a', '\u082b', '\u082c', '\u082d', '\u0859', '\u085a', 
+    '\u085b', '\u0897', '\u0898', '\u0899', '\u089a', '\u089b', '\u089c', '\u089d', 
+    '\u089e', '\u089f', '\u08ca', '\u08cb', '\u08cc', '\u08cd', '\u08ce', '\u08cf', 
+    '\u08d0', '\u08d1', '\u08d2', '\u08d3', '\u08d4', '\u08d5', '\u08d6', '\u08d7', 
+    '\u08d8', '\u08d9', '\u08da', '\u08db', '\u08dc', '\u08dd', '\u08de', '\u08df', 
+    '\u08e0', '\u08e1', '\u08e3', '\u08e4', '\u08e5', '\u08e6', '\u08e7', '\u08e8', 
+    '\u08e9', '\u08ea', '\u08eb', '\u08ec', '\u08ed', '\u08ee', '\u08ef', '\u08f0', 
+    '\u08f1', '\u08f2', '\u08f3', '\u08f4', '\u08f5', '\u08f6', '\u08f7', '\u08f8', 
+    '\u08f9', '\u08fa', '\u08fb', '\u08fc', '\u08fd', '\u08fe', '\u08ff', '\u0900', 
+    '\u0901', '\u0902', '\u093a', '\u093c', '\u0941', '\u0942', '\u0943', '\u0944', 
+    '\u0945', '\u0946', '\u0947', '\u0948', '\u094d', '\u0951', '\u0952', '\u0953', 
+    '\u0954', '\u0955', '\u0956', '\u0957', '\u0962', '\u0963', '\u0981', '\u09bc', 
+    '\u09be', '\u09c1', '\u09c2', '\u09c3', '\u09c4', '\u09cd', '\u09d7', '\u09e2', 
+    '\u09e3', '\u09fe', '\u0a01', '\u0a02', '\u0a3c', '\u0a41', '\u0a42', '\u0a47', 
+    '\u0a48', '\u0a4b', '\u0a4c', '\u0a4d', '\u0a51', '\u0a70', '\u0a71', '\u0a75', 
+    '\u0a81', '\u0a82', '\u0abc', '\u0ac1', '\u0ac2', '\u0ac3', '\u0ac4', '\u0ac5', 
+    '\u0ac7', '\u0ac8', '\u0acd', '\u0ae2', '\u0ae3', '\u0afa', '\u0afb', '\u0afc', 
+    '\u0afd', '\u0afe', '\u0aff', '\u0b01', '\u0b3c', '\u0b3e', '\u0b3f', '\u0b41', 
+    '\u0b42', '\u0b43', '\u0b44', '\u0b4d', '\u0b55', '\u0b56', '\u0b57', '\u0b62', 
+    '\u0b63', '\u0b82', '\u0bbe', '\u0bc0', '\u0bcd', '\u0bd7', '\u0c00', '\u0c04', 
+    '\u0c3c', '\u0c3e', '\u0c3f', '\u0c40', '\u0c46', '\u0c47', '\u0c48', '\u0c4a', 
+    '\u0c4b', '\u0c4c', '\u0c4d', '\u0c55', '\u0c56', '\u0c62', '\u0c63', '\u0c81', 
+    '\u0cbc', '\u0cbf', '\u0cc0', '\u0cc2', '\u0cc6', '\u0cc7', '\u0cc8', '\u0cca', 
+    '\u0ccb', '\u0ccc', '\u0ccd', '\u0cd5', '\u0cd6', '\u0ce2', '\u0ce3', '\u0d00', 
+    '\u0d01', '\u0d3b', '\u0d3c', '\u0d3e', '\u0d41', '\u0d42', '\u0d43', '\u0d44', 
+    '\u0d4d', '\u0d57', '\u0d62', '\u0d63', '\u0d81', '\u0dca', '\u0dcf', '\u0dd2', 
+    '\u0dd3', '\u0dd4', '\u0dd6', '\u0ddf', '\u0e31', '\u0e34', '\u0e35', '\u0e36', 
+    '\u0e37', '\u0e38', '\u0e39', '\u0e3a', '\u0e47', '\u0e48', '\u0e49', '\u0e4a', 
+    '\u0e4b', '\u0e4c', '\u0e4d', '\u0e4e', '\u0eb1', '\u0eb4', '\u0eb5', '\u0eb6', 
+    '\u0eb7', '\u0eb8', '\u0eb9', '\u0eba', '\u0ebb', '\u0ebc', '\u0ec8', '\u0ec9', 
+    '\u0eca', '\u0ecb', '\u0ecc', '\u0ecd', '\u0ece', '\u0f18', '\u0f19', '\u0f35', 
+    '\u0f37', '\u0f39', '\u0f71', '\u0f72', '\u0f73', '\u0f74', '\u0f75', '\u0f76', 
+    '\u0f77', '\u0f78', '\u0f79', '\u0f7a', '\u0f7b', '\u0f7c', '\u0f7d', '\u0f7e', 
+    '\u0f80', '\u0f81', '\u0f82', '\u0f83', '\u0f84', '\u0f86', '\u0f87', '\u0f8d', 
+    '\u0f8e', '\u0f8f', '\u0f90', '\u0f91', '\u0f92', '\u0f93', '\u0f94', '\u0f95', 
+    '\u0f96', '\u0f97', '\u0f99', '\u0f9a', '\u0f9b', '\u0f9c', '\u0f9d', '\u0f9e', 
+    '\u0f9f', '\u0fa0', '\u0fa1', '\u0fa2', '\u0fa3', '\u0fa4', '\u0fa5', '\u0fa6', 
+    '\u0fa7', '\u0fa8', '\u0fa9', '\u0faa', '\u0fab', '\u0fac', '\u0fad', '\u0fae', 
+    '\u0faf', '\u0fb0', '\u0fb1', '\u0fb2', '\u0fb3', '\u0fb4', '\u0fb5', '\u0fb6', 
+    '\u0fb7', '\u0fb8', '\u0fb9', '\u0fba', '\u0fbb', '\u0fbc', '\u0fc6', '\u102d', 
+    '\u102e', '\u102f', '\u1030', '\u1032', '\u1033', '\u1034', '\u1035', '\u1036', 
+    '\u1037', '\u1039', '\u103a', '\u103d', '\u103e', '\u1058', '\u1059', '\u105e', 
+    '\u105f', '\u1060', '\u1071', '\u1072', '\u1073', '\u1074', '\u1082', '\u1085', 
+    '\u1086', '\u108d', '\u109d', '\u135d', '\u135e', '\u135f', '\u1712', '\u1713', 
+    '\u1714', '\u1715', '\u1732', '\u1733', '\u1734', '\u1752', '\u1753', '\u1772', 
+    '\u1773', '\u17b4', '\u17b5', '\u17b7', '\u17b8', '\u17b9', '\u17ba', '\u17bb', 
+    '\u17bc', '\u17bd', '\u17c6', '\u17c9', '\u17ca', '\u17cb', '\u17cc', '\u17cd', 
+    '\u17ce', '\u17cf', '\u17d0', '\u17d1', '\u17d2', '\u17d3', '\u17dd', '\u180b', 
+    '\u180c', '\u180d', '\u180f', '\u1885', '\u1886', '\u18a9', '\u1920', '\u1921', 
+    '\u1922', '\u1927', '\u1928', '\u1932', '\u1939', '\u193a', '\u193b', '\u1a17', 
+    '\u1a18', '\u1a1b', '\u1a56', '\u1a58', '\u1a59', '\u1a5a', '\u1a5b', '\u1a5c', 
+    '\u1a5d', '\u1a5e', '\u1a60', '\u1a62', '\u1a65', '\u1a66', '\u1a67', '\u1a68', 
+    '\u1a69', '\u1a6a', '\u1a6b', '\u1a6c', '\u1a73', '\u1a74', '\u1a75', '\u1a76', 
+    '\u1a77', '\u1a78', '\u1a79', '\u1a7a', '\u1a7b', '\u1a7c', '\u1a7f', '\u1ab0', 
+    '\u1ab1', '\u1ab2', '\u1ab3', '\u1ab4', '\u1ab5', '\u1ab6', '\u1ab7', '\u1ab8', 
+    '\u1ab9', '\u1aba', '\u1abb', '\u1abc', '\u1abd', '\u1abe', '\u1abf', '\u1ac0', 
+    '\u1ac1', '\u1ac2', '\u1ac3', '\u1ac4', '\u1ac5', '\u1ac6', '\u1ac7', '\u1ac8', 
+    '\u1ac9', '\u1aca', '\u1acb', '\u1acc', '\u1acd', '\u1ace', '\u1acf', '\u1ad0', 
+    '\u1ad1', '\u1ad2', '\u1ad3', '\u1ad4', '\u1ad5', '\u1ad6', '\u1ad7', '\u1ad8', 
+    '\u1ad9', '\u1ada', '\u1adb', '\u1adc', '\u1add', '\u1ae0', '\u1ae1', '\u1ae2', 
+    '\u1ae3', '\u1ae4', '\u1ae5', '\u1ae6', '\u1ae7', '\u1ae8', '\u1ae9', '\u1aea', 
+    '\u1aeb', '\u1b00', '\u1b01', '\u1b02', '\u1b03', '\u1b34', '\u1b35', '\u1b36', 
+    '\u1b37', '\u1b38', '\u1b39', '\u1b3a', '\u1b3b', '\u1b3c', '\u1b3d', '\u1b42', 
+    '\u1b43', '\u1b44', '\u1b6b', '\u1b6c', '\u1b6d', '\u1b6e', '\u1b6f', '\u1b70', 
+    '\u1b71', '\u1b72', '\u1b73', '\u1b80', '\u1b81', '\u1ba2', '\u1ba3', '\u1ba4', 
+    '\u1ba5', '\u1ba8', '\u1ba9', '\u1baa', '\u1bab', '\u1bac', '\u1bad', '\u1be6', 
+    '\u1be8', '\u1be9', '\u1bed', '\u1bef', '\u1bf0', '\u1bf1', '\u1bf2', '\u1bf3', 
+    '\u1c2c', '\u1c2d', '\u1c2e', '\u1c2f', '\u1c30', '\u1c31', '\u1c32', '\u1c33', 
+    '\u1c36', '\u1c37', '\u1cd0', '\u1cd1', '\u1cd2', '\u1cd4', '\u1cd5', '\u1cd6', 
+    '\u1cd7', '\u1cd8', '\u1cd9', '\u1cda', '\u1cdb', '\u1cdc', '\u1cdd', '\u1cde', 
+    '\u1cdf', '\u1ce0', '\u1ce2', '\u1ce3', '\u1ce4', '\u1ce5', '\u1ce6', '\u1ce7', 
+    '\u1ce8', '\u1ced', '\u1cf4', '\u1cf8', '\u1cf9', '\u1dc0', '\u1dc1', '\u1dc2', 
+    '\u1dc3', '\u1dc4', '\u1dc5', '\u1dc6', '\u1dc7', '\u1dc8', '\u1dc9', '\u1dca', 
+    '\u1dcb', '\u1dcc', '\u1dcd', '\u1dce', '\u1dcf', '\u1dd0', '\u1dd1', '\u1dd2', 
+    '\u1dd3', '\u1dd4', '\u1dd5', '\u1dd6', '\u1dd7', '\u1dd8', '\u1dd9', '\u1dda', 
+    '\u1ddb', '\u1ddc', '\u1ddd', '\u1dde', '\u1ddf', '\u1de0', '\u1de1', '\u1de2', 
+    '\u1de3', '\u1de4', '\u1de5', '\u1de6', '\u1de7', '\u1de8', '\u1de9', '\u1dea', 
+    '\u1deb', '\u1dec', '\u1ded', '\u1dee', '\u1def', '\u1df0', '\u1df1', '\u1df2', 
+    '\u1df3', '\u1df4', '\u1df5', '\u1df6', '\u1df7', '\u1df8', '\u1df9', '\u1dfa', 
+    '\u1dfb', '\u1dfc', '\u1dfd', '\u1dfe', '\u1dff', '\u200c', '\u20d0', '\u20d1', 
+    '\u20d2', '\u20d3', '\u20d4', '\u20d5', '\u20d6', '\u20d7', '\u20d8', '\u20d9', 
+    '\u20da', '\u20db', '\u20dc', '\u20dd', '\u20de', '\u20df', '\u20e0', '\u20e1', 
+    '\u20e2', '\u20e3', '\u20e4', '\u20e5', '\u20e6', '\u20e7', '\u20e8', '\u20e9', 
+    '\u20ea', '\u20eb', '\u20ec', '\u20ed', '\u20ee', '\u20ef', '\u20f0', '\u2cef', 
+    '\u2cf0', '\u2cf1', '\u2d7f', '\u2de0', '\u2de1', '\u2de2', '\u2de3', '\u2de4', 
+    '\u2de5', '\u2de6', '\u2de7', '\u2de8', '\u2de9', '\u2dea', '\u2deb', '\u2dec', 
+    '\u2ded', '\u2dee', '\u2def', '\u2df0', '\u2df1', '\u2df2', '\u2df3', '\u2df4', 
+    '\u2df5', '\u2df6', '\u2df7', '\u2df8', '\u2df9', '\u2dfa', '\u2dfb', '\u2dfc', 
+    '\u2dfd', '\u2dfe', '\u2dff', '\u302a', '\u302b', '\u302c', '\u302d', '\u302e', 
+    '\u302f', '\u3099', '\u309a', '\ua66f', '\ua670', '\ua671', '\ua672', '\ua674', 
+    '\ua675', '\ua676', '\ua677', '\ua678', '\ua679', '\ua67a', '\ua67b', '\ua67c', 
+    '\ua67d', '\ua69e', '\ua69f', '\ua6f0', '\ua6f1', '\ua802', '\ua806', '\ua80b', 
+    '\ua825', '\ua826', '\ua82c', '\ua8c4', '\ua8c5', '\ua8e0', '\ua8e1', '\ua8e2', 
+    '\ua8e3', '\ua8e4', '\ua8e5', '\ua8e6', '\ua8e7', '\ua8e8', '\ua8e9', '\ua8ea', 
+    '\ua8eb', '\ua8ec', '\ua8ed', '\ua8ee', '\ua8ef', '\ua8f0', '\ua8f1', '\ua8ff', 
+    '\ua926', '\ua927', '\ua928', '\ua929', '\ua92a', '\ua92b', '\ua92c', '\ua92d', 
+    '\ua947', '\ua948', '\ua949', '\ua94a', '\ua94b', '\ua94c', '\ua94d', '\ua94e', 
+    '\ua94f', '\ua950', '\ua951', '\ua953', '\ua980', '\ua981', '\ua982', '\ua9b3', 
+    '\ua9b6', '\ua9b7', '\ua9b8', '\ua9b9', '\ua9bc', '\ua9bd', '\ua9c0', '\ua9e5', 
+    '\uaa29', '\uaa2a', '\uaa2b', '\uaa2c', '\uaa2d', '\uaa2e', '\uaa31', '\uaa32', 
+    '\uaa35', '\uaa36', '\uaa43', '\uaa4c', '\uaa7c', '\uaab0', '\uaab2', '\uaab3', 
+    '\uaab4', '\uaab7', '\uaab8', '\uaabe', '\uaabf', '\uaac1', '\uaaec', '\uaaed', 
+    '\uaaf6', '\uabe5', '\uabe8', '\uabed', '\ufb1e', '\ufe00', '\ufe01', '\ufe02', 
+    '\ufe03', '\ufe04', '\ufe05', '\ufe06', '\ufe07', '\ufe08', '\ufe09', '\ufe0a', 
+    '\ufe0b', '\ufe0c', '\ufe0d', '\ufe0e', '\ufe0f', '\ufe20', '\ufe21', '\ufe22', 
+    '\ufe23', '\ufe24', '\ufe25', '\ufe26', '\ufe27', '\ufe28', '\ufe29', '\ufe2a', 
+    '\ufe2b', '\ufe2c', '\ufe2d', '\ufe2e', '\ufe2f', '\uff9e', '\uff9f', '\U000101fd', 
+    '\U000102e0', '\U00010376', '\U00010377', '\U00010378', '\U00010379', '\U0001037a', '\U00010a01', '\U00010a02', 
+    '\U00010a03', '\U00010a05', '\U00010a06', '\U00010a0c', '\U00010a0d', '\U00010a0e', '\U00010a0f', '\U00010a38', 
+    '\U00010a39', '\U00010a3a', '\U00010a3f', '\U00010ae5', '\U00010ae6', '\U00010d24', '\U00010d25', '\U00010d26', 
+    '\U00010d27', '\U00010d69', '\U00010d6a', '\U00010d6b', '\U00010d6c', '\U00010d6d', '\U00010eab', '\U00010eac', 
+    '\U00010efa', '\U00010efb', '\U00010efc', '\U00010efd', '\U00010efe', '\U00010eff', '\U00010f46', '\U00010f47', 
+    '\U00010f48', '\U00010f49', '\U00010f4a', '\U00010f4b', '\U00010f4c', '\U00010f4d', '\U00010f4e', '\U00010f4f', 
+    '\U00010f50', '\U00010f82', '\U00010f83', '\U00010f84', '\U00010f85', '\U00011001', '\U00011038', '\U00011039', 
+    '\U0001103a', '\U0001103b', '\U0001103c', '\U0001103d', '\U0001103e', '\U0001103f', '\U00011040', '\U00011041', 
+    '\U00011042', '\U00011043', '\U00011044', '\U00011045', '\U00011046', '\U00011070', '\U00011073', '\U00011074', 
+    '\U0001107f', '\U00011080', '\U00011081', '\U000110b3', '\U000110b4', '\U000110b5', '\U000110b6', '\U000110b9', 
+    '\U000110ba', '\U000110c2', '\U00011100', '\U00011101', '\U00011102', '\U00011127', '\U00011128', '\U00011129', 
+    '\U0001112a', '\U0001112b', '\U0001112d', '\U0001112e', '\U0001112f', '\U00011130', '\U00011131', '\U00011132', 
+    '\U00011133', '\U00011134', '\U00011173', '\U00011180', '\U00011181', '\U000111b6', '\U000111b7', '\U000111b8', 
+    '\U000111b9', '\U000111ba', '\U000111bb', '\U000111bc', '\U000111bd', '\U000111be', '\U000111c0', '\U000111c9', 
+    '\U000111ca', '\U000111cb', '\U000111cc', '\U000111cf', '\U0001122f', '\U00011230', '\U00011231', '\U00011234', 
+    '\U00011235', '\U00011236', '\U00011237', '\U0001123e', '\U00011241', '\U000112df', '\U000112e3', '\U000112e4', 
+    '\U000112e5', '\U000112e6', '\U000112e7', '\U000112e8', '\U000112e9', '\U000112ea', '\U00011300', '\U00011301', 
+    '\U0001133b', '\U0001133c', '\U0001133e', '\U00011340', '\U0001134d', '\U00011357', '\U00011366', '\U00011367', 
+    '\U00011368', '\U00011369', '\U0001136a', '\U0001136b', '\U0001136c', '\U00011370', '\U00011371', '\U00011372', 
+    '\U00011373', '\U00011374', '\U000113b8', '\U000113bb', '\U000113bc', '\U000113bd', '\U000113be', '\U000113bf', 
+    '\U000113c0', '\U000113c2', '\U000113c5', '\U000113c7', '\U000113c8', '\U000113c9', '\U000113ce', '\U000113cf', 
+    '\U000113d0', '\U000113d2', '\U000113e1', '\U000113e2', '\U00011438', '\U00011439', '\U0001143a', '\U0001143b', 
+    '\U0001143c', '\U0001143d', '\U0001143e', '\U0001143f', '\U00011442', '\U00011443', '\U00011444', '\U00011446', 
+    '\U0001145e', '\U000114b0', '\U000114b3', '\U000114b4', '\U000114b5', '\U000114b6', '\U000114b7', '\U000114b8', 
+    '\U000114ba', '\U000114bd', '\U000114bf', '\U000114c0', '\U000114c2', '\U000114c3', '\U000115af', '\U000115b2', 
+    '\U000115b3', '\U000115b4', '\U000115b5', '\U000115bc', '\U000115bd', '\U000115bf', '\U000115c0', '\U000115dc', 
+    '\U000115dd', '\U00011633', '\U00011634', '\U00011635', '\U00011636', '\U00011637', '\U00011638', '\U00011639', 
+    '\U0001163a', '\U0001163d', '\U0001163f', '\U00011640', '\U000116ab', '\U000116ad', '\U000116b0', '\U000116b1', 
+    '\U000116b2', '\U000116b3', '\U000116b4', '\U000116b5', '\U000116b6', '\U000116b7', '\U0001171d', '\U0001171f', 
+    '\U00011722', '\U00011723', '\U00011724', '\U00011725', '\U00011727', '\U00011728', '\U00011729', '\U0001172a', 
+    '\U0001172b', '\U0001182f', '\U00011830', '\U00011831', '\U00011832', '\U00011833', '\U00011834', '\U00011835', 
+    '\U00011836', '\U00011837', '\U00011839', '\U0001183a', '\U00011930', '\U0001193b', '\U0001193c', '\U0001193d', 
+    '\U0001193e', '\U00011943', '\U000119d4', '\U000119d5', '\U000119d6', '\U000119d7', '\U000119da', '\U000119db', 
+    '\U000119e0', '\U00011a01', '\U00011a02', '\U00011a03', '\U00011a04', '\U00011a05', '\U00011a06', '\U00011a07', 
+    '\U00011a08', '\U00011a09', '\U00011a0a', '\U00011a33', '\U00011a34', '\U00011a35', '\U00011a36', '\U00011a37', 
+    '\U00011a38', '\U00011a3b', '\U00011a3c', '\U00011a3d', '\U00011a3e', '\U00011a47', '\U00011a51', '\U00011a52', 
+    '\U00011a53', '\U00011a54', '\U00011a55', '\U00011a56', '\U00011a59', '\U00011a5a', '\U00011a5b', '\U00011a8a', 
+    '\U00011a8b', '\U00011a8c', '\U00011a8d', '\U00011a8e', '\U00011a8f', '\U00011a90', '\U00011a91', '\U00011a92', 
+    '\U00011a93', '\U00011a94', '\U00011a95', '\U00011a96', '\U00011a98', '\U00011a99', '\U00011b60', '\U00011b62', 
+    '\U00011b63', '\U00011b64', '\U00011b66', '\U00011c30', '\U00011c31', '\U00011c32', '\U00011c33', '\U00011c34', 
+    '\U00011c35', '\U00011c36', '\U00011c38', '\U00011c39', '\U00011c3a', '\U00011c3b', '\U00011c3c', '\U00011c3d', 
+    '\U00011c3f', '\U00011c92', '\U00011c93', '\U00011c94', '\U00011c95', '\U00011c96', '\U00011c97', '\U00011c98', 
+    '\U00011c99', '\U00011c9a', '\U00011c9b', '\U00011c9c', '\U00011c9d', '\U00011c9e', '\U00011c9f', '\U00011ca0', 
+    '\U00011ca1', '\U00011ca2', '\U00011ca3', '\U00011ca4', '\U00011ca5', '\U00011ca6', '\U00011ca7', '\U00011caa', 
+    '\U00011cab', '\U00011cac', '\U00011cad', '\U00011cae', '\U00011caf', '\U00011cb0', '\U00011cb2', '\U00011cb3', 
+    '\U00011cb5', '\U00011cb6', '\U00011d31', '\U00011d32', '\U00011d33', '\U00011d34', '\U00011d35', '\U00011d36', 
+    '\U00011d3a', '\U00011d3c', '\U00011d3d', '\U00011d3f', '\U00011d40', '\U00011d41', '\U00011d42', '\U00011d43', 
+    '\U00011d44', '\U00011d45', '\U00011d47', '\U00011d90', '\U00011d91', '\U00011d95', '\U00011d97', '\U00011ef3', 
+    '\U00011ef4', '\U00011f00', '\U00011f01', '\U00011f36', '\U00011f37', '\U00011f38', '\U00011f39', '\U00011f3a', 
+    '\U00011f40', '\U00011f41', '\U00011f42', '\U00011f5a', '\U00013440', '\U00013447', '\U00013448', '\U00013449', 
+    '\U0001344a', '\U0001344b', '\U0001344c', '\U0001344d', '\U0001344e', '\U0001344f', '\U00013450', '\U00013451', 
+    '\U00013452', '\U00013453', '\U00013454', '\U00013455', '\U0001611e', '\U0001611f', '\U00016120', '\U00016121', 
+    '\U00016122', '\U00016123', '\U00016124', '\U00016125', '\U00016126', '\U00016127', '\U00016128', '\U00016129', 
+    '\U0001612d', '\U0001612e', '\U0001612f', '\U00016af0', '\U00016af1', '\U00016af2', '\U00016af3', '\U00016af4', 
+    '\U00016b30', '\U00016b31', '\U00016b32', '\U00016b33', '\U00016b34', '\U00016b35', '\U00016b36', '\U00016f4f', 
+    '\U00016f8f', '\U00016f90', '\U00016f91', '\U00016f92', '\U00016fe4', '\U00016ff0', '\U00016ff1', '\U0001bc9d', 
+    '\U0001bc9e', '\U0001cf00', '\U0001cf01', '\U0001cf02', '\U0001cf03', '\U0001cf04', '\U0001cf05', '\U0001cf06', 
+    '\U0001cf07', '\U0001cf08', '\U0001cf09', '\U0001cf0a', '\U0001cf0b', '\U0001cf0c', '\U0001cf0d', '\U0001cf0e', 
+    '\U0001cf0f', '\U0001cf10', '\U0001cf11', '\U0001cf12', '\U0001cf13', '\U0001cf14', '\U0001cf15', '\U0001cf16', 
+    '\U0001cf17', '\U0001cf18', '\U0001cf19', '\U0001cf1a', '\U0001cf1b', '\U0001cf1c', '\U0001cf1d', '\U0001cf1e', 
+    '\U0001cf1f', '\U0001cf20', '\U0001cf21', '\U0001cf22', '\U0001cf23', '\U0001cf24', '\U0001cf25', '\U0001cf26', 
+    '\U0001cf27', '\U0001cf28', '\U0001cf29', '\U0001cf2a', '\U0001cf2b', '\U0001cf2c', '\U0001cf2d', '\U0001cf30', 
+    '\U0001cf31', '\U0001cf32', '\U0001cf33', '\U0001cf34', '\U0001cf35', '\U0001cf36', '\U0001cf37', '\U0001cf38', 
+    '\U0001cf39', '\U0001cf3a', '\U0001cf3b', '\U0001cf3c', '\U0001cf3d', '\U0001cf3e', '\U0001cf3f', '\U0001cf40', 
+    '\U0001cf41', '\U0001cf42', '\U0001cf43', '\U0001cf44', '\U0001cf45', '\U0001cf46', '\U0001d165', '\U0001d166', 
+    '\U0001d167', '\U0001d168', '\U0001d169', '\U0001d16d', '\U0001d16e', '\U0001d16f', '\U0001d170', '\U0001d171', 
+    '\U0001d172', '\U0001d17b', '\U0001d17c', '\U0001d17d', '\U0001d17e', '\U0001d17f', '\U0001d180', '\U0001d181', 
+    '\U0001d182', '\U0001d185', '\U0001d186', '\U0001d187', '\U0001d188', '\U0001d189', '\U0001d18a', '\U0001d18b', 
+    '\U0001d1aa', '\U0001d1ab', '\U0001d1ac', '\U0001d1ad', '\U0001d242', '\U0001d243', '\U0001d244', '\U0001da00', 
+    '\U0001da01', '\U0001da02', '\U0001da03', '\U0001da04', '\U0001da05', '\U0001da06', '\U0001da07', '\U0001da08', 
+    '\U0001da09', '\U0001da0a', '\U0001da0b', '\U0001da0c', '\U0001da0d', '\U0001da0e', '\U0001da0f', '\U0001da10', 
+    '\U0001da11', '\U0001da12', '\U0001da13', '\U0001da14', '\U0001da15', '\U0001da16', '\U0001da17', '\U0001da18', 
+    '\U0001da19', '\U0001da1a', '\U0001da1b', '\U0001da1c', '\U0001da1d', '\U0001da1e', '\U0001da1f', '\U0001da20', 
+    '\U0001da21', '\U0001da22', '\U0001da23', '\U0001da24', '\U0001da25', '\U0001da26', '\U0001da27', '\U0001da28', 
+    '\U0001da29', '\U0001da2a', '\U0001da2b', '\U0001da2c', '\U0001da2d', '\U0001da2e', '\U0001da2f', '\U0001da30', 
+    '\U0001da31', '\U0001da32', '\U0001da33', '\U0001da34', '\U0001da35', '\U0001da36', '\U0001da3b', '\U0001da3c', 
+    '\U0001da3d', '\U0001da3e', '\U0001da3f', '\U0001da40', '\U0001da41', '\U0001da42', '\U0001da43', '\U0001da44', 
+    '\U0001da45', '\U0001da46', '\U0001da47', '\U0001da48', '\U0001da49', '\U0001da4a', '\U0001da4b', '\U0001da4c', 
+    '\U0001da4d', '\U0001da4e', '\U0001da4f', '\U0001da50', '\U0001da51', '\U0001da52', '\U0001da53', '\U0001da54', 
+    '\U0001da55', '\U0001da56', '\U0001da57', '\U0001da58', '\U0001da59', '\U0001da5a', '\U0001da5b', '\U0001da5c', 
+    '\U0001da5d', '\U0001da5e', '\U0001da5f', '\U0001da60', '\U0001da61', '\U0001da62', '\U0001da63', '\U0001da64', 
+    '\U0001da65', '\U0001da66', '\U0001da67', '\U0001da68', '\U0001da69', '\U0001da6a', '\U0001da6b', '\U0001da6c', 
+    '\U0001da75', '\U0001da84', '\U0001da9b', '\U0001da9c', '\U0001da9d', '\U0001da9e', '\U0001da9f', '\U0001daa1', 
+    '\U0001daa2', '\U0001daa3', '\U0001daa4', '\U0001daa5', '\U0001daa6', '\U0001daa7', '\U0001daa8', '\U0001daa9', 
+    '\U0001daaa', '\U0001daab', '\U0001daac', '\U0001daad', '\U0001daae', '\U0001daaf', '\U0001e000', '\U0001e001', 
+    '\U0001e002', '\U0001e003', '\U0001e004', '\U0001e005', '\U0001e006', '\U0001e008', '\U0001e009', '\U0001e00a', 
+    '\U0001e00b', '\U0001e00c', '\U0001e00d', '\U0001e00e', '\U0001e00f', '\U0001e010', '\U0001e011', '\U0001e012', 
+    '\U0001e013', '\U0001e014', '\U0001e015', '\U0001e016', '\U0001e017', '\U0001e018', '\U0001e01b', '\U0001e01c', 
+    '\U0001e01d', '\U0001e01e', '\U0001e01f', '\U0001e020', '\U0001e021', '\U0001e023', '\U0001e024', '\U0001e026', 
+    '\U0001e027', '\U0001e028', '\U0001e029', '\U0001e02a', '\U0001e08f', '\U0001e130', '\U0001e131', '\U0001e132', 
+    '\U0001e133', '\U0001e134', '\U0001e135', '\U0001e136', '\U0001e2ae', '\U0001e2ec', '\U0001e2ed', '\U0001e2ee', 
+    '\U0001e2ef', '\U0001e4ec', '\U0001e4ed', '\U0001e4ee', '\U0001e4ef', '\U0001e5ee', '\U0001e5ef', '\U0001e6e3', 
+    '\U0001e6e6', '\U0001e6ee', '\U0001e6ef', '\U0001e6f5', '\U0001e8d0', '\U0001e8d1', '\U0001e8d2', '\U0001e8d3', 
+    '\U0001e8d4', '\U0001e8d5', '\U0001e8d6', '\U0001e944', '\U0001e945', '\U0001e946', '\U0001e947', '\U0001e948', 
+    '\U0001e949', '\U0001e94a', '\U0001f3fb', '\U0001f3fc', '\U0001f3fd', '\U0001f3fe', '\U0001f3ff', '\U000e0020', 
+    '\U000e0021', '\U000e0022', '\U000e0023', '\U000e0024', '\U000e0025', '\U000e0026', '\U000e0027', '\U000e0028', 
+    '\U000e0029', '\U000e002a', '\U000e002b', '\U000e002c', '\U000e002d', '\U000e002e', '\U000e002f', '\U000e0030', 
+    '\U000e0031', '\U000e0032', '\U000e0033', '\U000e0034', '\U000e0035', '\U000e0036', '\U000e0037', '\U000e0038', 
+    '\U000e0039', '\U000e003a', '\U000e003b', '\U000e003c', '\U000e003d', '\U000e003e', '\U000e003f', '\U000e0040', 
+    '\U000e0041', '\U000e0042', '\U000e0043', '\U000e0044', '\U000e0045', '\U000e0046', '\U000e0047', '\U000e0048', 
+    '\U000e0049', '\U000e004a', '\U000e004b', '\U000e004c', '\U000e004d', '\U000e004e', '\U000e004f', '\U000e0050', 
+    '\U000e0051', '\U000e0052', '\U000e0053', '\U000e0054', '\U000e0055', '\U000e0056', '\U000e0057', '\U000e0058', 
+    '\U000e0059', '\U000e005a', '\U000e005b', '\U000e005c', '\U000e005d', '\U000e005e', '\U000e005f', '\U000e0060', 
+    '\U000e0061', '\U000e0062', '\U000e0063', '\U000e0064', '\U000e0065', '\U000e0066', '\U000e0067', '\U000e0068', 
+    '\U000e0069', '\U000e006a', '\U000e006b', '\U000e006c', '\U000e006d', '\U000e006e', '\U000e006f', '\U000e0070', 
+    '\U000e0071', '\U000e0072', '\U000e0073', '\U000e0074', '\U000e0075', '\U000e0076', '\U000e0077', '\U000e0078', 
+    '\U000e0079', '\U000e007a', '\U000e007b', '\U000e007c', '\U000e007d', '\U000e007e', '\U000e007f', '\U000e0100', 
+    '\U000e0101', '\U000e0102', '\U000e0103', '\U000e0104', '\U000e0105', '\U000e0106', '\U000e0107', '\U000e0108', 
+    '\U000e0109', '\U000e010a', '\U000e010b', '\U000e010c', '\U000e010d', '\U000e010e', '\U000e010f', '\U000e0110', 
+    '\U000e0111', '\U000e0112', '\U000e0113', '\U000e0114', '\U000e0115', '\U000e0116', '\U000e0117', '\U000e0118', 
+    '\U000e0119', '\U000e011a', '\U000e011b', '\U000e011c', '\U000e011d', '\U000e011e', '\U000e011f', '\U000e0120', 
+    '\U000e0121', '\U000e0122', '\U000e0123', '\U000e0124', '\U000e0125', '\U000e0126', '\U000e0127', '\U000e0128', 
+    '\U000e0129', '\U000e012a', '\U000e012b', '\U000e012c', '\U000e012d', '\U000e012e', '\U000e012f', '\U000e0130', 
+    '\U000e0131', '\U000e0132', '\U000e0133', '\U000e0134', '\U000e0135', '\U000e0136', '\U000e0137', '\U000e0138', 
+    '\U000e0139', '\U000e013a', '\U000e013b', '\U000e013c', '\U000e013d', '\U000e013e', '\U000e013f', '\U000e0140', 
+    '\U000e0141', '\U000e0142', '\U000e0143', '\U000e0144', '\U000e0145', '\U000e0146', '\U000e0147', '\U000e0148', 
+    '\U000e0149', '\U000e014a', '\U000e014b', '\U000e014c', '\U000e014d', '\U000e014e', '\U000e014f', '\U000e0150', 
+    '\U000e0151', '\U000e0152', '\U000e0153', '\U000e0154', '\U000e0155', '\U000e0156', '\U000e0157', '\U000e0158', 
+    '\U000e0159', '\U000e015a', '\U000e015b', '\U000e015c', '\U000e015d', '\U000e015e', '\U000e015f', '\U000e0160', 
+    '\U000e0161', '\U000e0162', '\U000e0163', '\U000e0164', '\U000e0165', '\U000e0166', '\U000e0167', '\U000e0168', 
+    '\U000e0169', '\U000e016a', '\U000e016b', '\U000e016c', '\U000e016d', '\U000e016e', '\U000e016f', '\U000e0170', 
+    '\U000e0171', '\U000e0172', '\U000e0173', '\U000e0174', '\U000e0175', '\U000e0176', '\U000e0177', '\U000e0178', 
+    '\U000e0179', '\U000e017a', '\U000e017b', '\U000e017c', '\U000e017d', '\U000e017e', '\U000e017f', '\U000e0180', 
+    '\U000e0181', '\U000e0182', '\U000e0183', '\U000e0184', '\U000e0185', '\U000e0186', '\U000e0187', '\U000e0188', 
+    '\U000e0189', '\U000e018a', '\U000e018b', '\U000e018c', '\U000e018d', '\U000e018e', '\U000e018f', '\U000e0190', 
+    '\U000e0191', '\U000e0192', '\U000e0193', '\U000e0194', '\U000e0195', '\U000e0196', '\U000e0197', '\U000e0198', 
+    '\U000e0199', '\U000e019a', '\U000e019b', '\U000e019c', '\U000e019d', '\U000e019e', '\U000e019f', '\U000e01a0', 
+    '\U000e01a1', '\U000e01a2', '\U000e01a3', '\U000e01a4', '\U000e01a5', '\U000e01a6', '\U000e01a7', '\U000e01a8', 
+    '\U000e01a9', '\U000e01aa', '\U000e01ab', '\U000e01ac', '\U000e01ad', '\U000e01ae', '\U000e01af', '\U000e01b0', 
+    '\U000e01b1', '\U000e01b2', '\U000e01b3', '\U000e01b4', '\U000e01b5', '\U000e01b6', '\U000e01b7', '\U000e01b8', 
+    '\U000e01b9', '\U000e01ba', '\U000e01bb', '\U000e01bc', '\U000e01bd', '\U000e01be', '\U000e01bf', '\U000e01c0', 
+    '\U000e01c1', '\U000e01c2', '\U000e01c3', '\U000e01c4', '\U000e01c5', '\U000e01c6', '\U000e01c7', '\U000e01c8', 
+    '\U000e01c9', '\U000e01ca', '\U000e01cb', '\U000e01cc', '\U000e01cd', '\U000e01ce', '\U000e01cf', '\U000e01d0', 
+    '\U000e01d1', '\U000e01d2', '\U000e01d3', '\U000e01d4', '\U000e01d5', '\U000e01d6', '\U000e01d7', '\U000e01d8', 
+    '\U000e01d9', '\U000e01da', '\U000e01db', '\U000e01dc', '\U000e01dd', '\U000e01de', '\U000e01df', '\U000e01e0', 
+    '\U000e01e1', '\U000e01e2', '\U000e01e3', '\U000e01e4', '\U000e01e5', '\U000e01e6', '\U000e01e7', '\U000e01e8', 
+    '\U000e01e9', '\U000e01ea', '\U000e01eb', '\U000e01ec', '\U000e01ed', '\U000e01ee', '\U000e01ef')
+    rangeFromGraphemeClass[int(ExtendClass)] = Extend
+
+    // Range for Grapheme class ZWJ
+    ZWJ = rangetable.New('\u200d')
+    rangeFromGraphemeClass[int(ZWJClass)] = ZWJ
+
+    // Range for Grapheme class Prepend
+    Prepend = rangetable.New('\u0600', '\u0601', '\u0602', '\u0603', '\u0604', '\u0605', 
+    '\u06dd', '\u070f', '\u0890', '\u0891', '\u08e2', '\u0d4e', '\U000110bd', '\U000110cd', 
+    '\U000111c2', '\U000111c3', '\U000113d1', '\U0001193f', '\U00011941', '\U00011a84', '\U00011a85', '\U00011a86', 
+    '\U00011a87', '\U00011a88', '\U00011a89', '\U00011d46', '\U00011f02')
+    rangeFromGraphemeClass[int(PrependClass)] = Prepend
+
+    // Range for Grapheme class L
+    L = rangetable.New('\u1100', '\u1101', '\u1102', '\u1103', '\u1104', '\u1105', 
+    '\u1106', '\u1107', '\u1108', '\u1109', '\u110a', '\u110b', '\u110c', '\u110d', 
+    '\u110e', '\u110f', '\u1110', '\u1111', '\u1112', '\u1113', '\u1114', '\u1115', 
+    '\u1116', '\u1117', '\u1118', '\u1119', '\u111a', '\u111b', '\u111c', '\u111d', 
+    '\u111e', '\u111f', '\u1120', '\u1121', '\u1122', '\u1123', '\u1124', '\u1125', 
+    '\u1126', '\u1127', '\u1128', '\u1129', '\u112a', '\u112b', '\u112c', '\u112d', 
+    '\u112e', '\u112f', '\u1130', '\u1131', '\u1132', '\u1133', '\u1134', '\u1135', 
+    '\u1136', '\u1137', '\u1138', '\u1139', '\u113a', '\u113b', '\u113c', '\u113d', 
+    '\u113e', '\u113f', '\u1140', '\u1141', '\u1142', '\u1143', '\u1144', '\u1145', 
+    '\u1146', '\u1147', '\u1148', '\u1149', '\u114a', '\u114b', '\u114c', '\u114d', 
+    '\u114e', '\u114f', '\u1150', '\u1151', '\u1152', '\u1153', '\u1154', '\u1155', 
+    '\u1156', '\u1157', '\u1158', '\u1159', '\u115a', '\u115b', '\u115c', '\u115d', 
+    '\u115e', '\u115f', '\ua960', '\ua961', '\ua962', '\ua963', '\ua964', '\ua965', 
+    '\ua966', '\ua967', '\ua968', '\ua969', '\ua96a', '\ua96b', '\ua96c', '\ua96d', 
+    '\ua96e', '\ua96f', '\ua970', '\ua971', '\ua972', '\ua973', '\ua974', '\ua975', 
+    '\ua976', '\ua977', '\ua978', '\ua979', '\ua97a', '\ua97b', '\ua97c')
+    rangeFromGraphemeClass[int(LClass)] = L
+
+    // Range for Grapheme class T
+    T = rangetable.New('\u11a8', '\u11a9', '\u11aa', '\u11ab', '\u11ac', '\u11ad', 
+    '\u11ae', '\u11af', '\u11b0', '\u11b1', '\u11b2', '\u11b3', '\u11b4', '\u11b5', 
+    '\u11b6', '\u11b7', '\u11b8', '\u11b9', '\u11ba', '\u11bb', '\u11bc', '\u11bd', 
+    '\u11be', '\u11bf', '\u11c0', '\u11c1', '\u11c2', '\u11c3', '\u11c4', '\u11c5', 
+    '\u11c6', '\u11c7', '\u11c8', '\u11c9', '\u11ca', '\u11cb', '\u11cc', '\u11cd', 
+    '\u11ce', '\u11cf', '\u11d0', '\u11d1', '\u11d2', '\u11d3', '\u11d4', '\u11d5', 
+    '\u11d6', '\u11d7', '\u11d8', '\u11d9', '\u11da', '\u11db', '\u11dc', '\u11dd', 
+    '\u11de', '\u11df', '\u11e0', '\u11e1', '\u11e2', '\u11e3', '\u11e4', '\u11e5', 
+    '\u11e6', '\u11e7', '\u11e8', '\u11e9', '\u11ea', '\u11eb', '\u11ec', '\u11ed', 
+    '\u11ee', '\u11ef', '\u11f0', '\u11f1', '\u11f2', '\u11f3', '\u11f4', '\u11f5', 
+    '\u11f6', '\u11f7', '\u11f8', '\u11f9', '\u11fa', '\u11fb', '\u11fc', '\u11fd', 
+    '\u11fe', '\u11ff', '\ud7cb', '\ud7cc', '\ud7cd', '\ud7ce', '\ud7cf', '\ud7d0', 
+    '\ud7d1', '\ud7d2', '\ud7d3', '\ud7d4', '\ud7d5', '\ud7d6', '\ud7d7', '\ud7d8', 
+    '\ud7d9', '\ud7da', '\ud7db', '\ud7dc', '\ud7dd', '\ud7de', '\ud7df', '\ud7e0', 
+    '\ud7e1', '\ud7e2', '\ud7e3', '\ud7e4', '\ud7e5', '\ud7e6', '\ud7e7', '\ud7e8', 
+    '\ud7e9', '\ud7ea', '\ud7eb', '\ud7ec', '\ud7ed', '\ud7ee', '\ud7ef', '\ud7f0', 
+    '\ud7f1', '\ud7f2', '\ud7f3', '\ud7f4', '\ud7f5', '\ud7f6', '\ud7f7', '\ud7f8', 
+    '\ud7f9', '\ud7fa', '\ud7fb')
+    rangeFromGraphemeClass[int(TClass)] = T
+}
+
+// graphemeClassTable is a two-stage lookup table for code-point classes.
+var graphemeClassTable = &classtable.Table{
+	Index: []uint16{
+		0, 1, 2, 2, 2, 2, 3, 2, 2, 4, 2, 5, 6, 7, 8, 9,
+		10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25,
+		26, 27, 28, 29, 2, 2, 30, 2, 2, 2, 2, 2, 2, 2, 31, 32,
+		33, 34, 35, 2, 36, 37, 38, 39, 40, 41, 2, 42, 2, 2, 2, 2,
+		43, 44, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 45, 46, 47, 2, 2, 2, 2,
+		48, 49, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 50, 51, 2, 2,
+		52, 53, 54, 55, 56, 57, 2, 58, 59, 60, 61, 62, 63, 64, 65, 59,
+		60, 61, 62, 63, 64, 65, 59, 60, 61, 62, 63, 64, 65, 59, 60, 61,
+		62, 63, 64, 65, 59, 60, 61, 62, 63, 64, 65, 59, 60, 61, 62, 63,
+		64, 65, 59, 60, 61, 62, 63, 64, 65, 59, 60, 61, 62, 63, 64, 65,
+		59, 60, 61, 62, 63, 64, 65, 59, 60, 61, 62, 63, 64, 65, 59, 60,
+		61, 62, 63, 64, 65, 59, 60, 61, 62, 63, 64, 65, 59, 60, 61, 66,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 67, 2, 2, 2, 2, 2, 68, 69, 2, 70,
+		2, 2, 2, 71, 2, 72, 73, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 74, 75, 2, 2, 2, 2, 76, 2, 2, 77, 78, 79,
+		80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 2, 90, 91, 92, 93, 2,
+		94, 2, 95, 96, 97, 98, 99, 2, 100, 101, 102, 103, 2, 104, 105, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 106, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 107, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 108, 109, 2, 2, 2, 110, 2, 2, 2, 111, 112,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 113, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 114, 2,
+		2, 2, 115, 116, 117, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 118, 119, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		120, 121, 109, 2, 2, 122, 2, 2, 2, 123, 2, 124, 2, 125, 2, 2,
+		2, 126, 127, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 128, 2, 2, 2, 129, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		130, 131, 132, 133, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+		131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
+	},
+	Blocks: []uint8{
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 2, 4, 4, 1, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 5,
+		0, 5, 5, 0, 5, 5, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		3, 3, 3, 3, 3, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 4, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 3, 0, 5,
+		5, 5, 5, 5, 5, 0, 0, 5, 5, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 0, 5, 5, 5, 0, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 3, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 3, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 5, 0, 7, 7,
+		7, 5, 5, 5, 5, 5, 5, 5, 5, 7, 7, 7, 7, 5, 7, 7, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 5, 7,
+		7, 5, 5, 5, 5, 0, 0, 7, 7, 0, 0, 7, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0,
+		0, 5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 7, 7,
+		7, 5, 5, 0, 0, 0, 0, 5, 5, 0, 0, 5, 5, 5, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 7, 7,
+		7, 5, 5, 5, 5, 5, 0, 5, 5, 7, 0, 7, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5,
+		0, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 5, 5,
+		7, 5, 5, 5, 5, 0, 0, 7, 7, 0, 0, 7, 7, 5, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7,
+		5, 7, 7, 0, 0, 0, 7, 7, 7, 0, 7, 7, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 7, 7, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 5, 5,
+		5, 7, 7, 7, 7, 0, 5, 5, 5, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 7, 5,
+		5, 7, 5, 7, 7, 0, 5, 5, 5, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 5, 7,
+		7, 5, 5, 5, 5, 0, 7, 7, 7, 0, 7, 7, 7, 5, 3, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 5, 7, 7, 5, 5, 5, 0, 5, 0, 7, 7, 7, 7, 7, 7, 7, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 7, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 7, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 5, 0, 5, 0, 0, 0, 0, 7, 7,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7,
+		5, 5, 5, 5, 5, 0, 5, 5, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 7, 5, 5, 5, 5, 5, 5, 0, 5, 5, 7, 7, 5, 5, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 5, 5, 0, 0, 0, 0, 5, 5,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 0, 7, 5, 5, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 7, 5, 5, 5, 5, 5, 5, 5, 7, 7,
+		7, 7, 7, 7, 7, 7, 5, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 4, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 7, 7, 7, 7, 5, 5, 7, 7, 7, 0, 0, 0, 0, 7, 7, 5, 7, 7, 7, 7, 7, 7, 5, 5, 5, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 7, 7, 5, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 5, 7, 5, 5, 5, 5, 5, 5, 5, 0,
+		5, 0, 5, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 7, 7, 7, 7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7, 7,
+		7, 7, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 7, 5, 5, 5, 5, 7, 7, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 5, 7, 5, 5, 7, 7, 7, 5, 7, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 7, 7, 7, 7, 7, 7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 7, 7, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 7, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 5, 0, 0, 7, 5, 5, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 5, 13, 4, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 0, 0, 0, 5, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 7, 7, 5, 5, 7, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 0, 0, 0,
+		5, 5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 7, 5, 5, 5, 5, 7, 7, 5, 5, 7, 7,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 7, 7, 5, 5, 7, 7, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 5, 5, 5, 0, 0, 5, 5, 0, 0, 0, 0, 0, 5, 5,
+		0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 5, 5, 7, 7, 0, 0, 0, 0, 0, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 7, 7, 5, 7, 7, 5, 7, 7, 0, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 12, 12, 12, 12, 11, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+		12, 12, 12, 12, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+		9, 9, 9, 9, 9, 9, 9, 0, 0, 0, 0, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0,
+		0, 5, 5, 5, 0, 5, 5, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 0, 0, 0, 0, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		7, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5,
+		5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 5, 7, 7, 5, 5, 0, 0, 3, 0, 0,
+		0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 7, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7,
+		5, 0, 3, 3, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 7, 7, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 5, 0,
+		0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5,
+		7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 5, 7,
+		5, 7, 7, 7, 7, 0, 0, 7, 7, 0, 0, 7, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 7, 7, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 7, 5, 5, 5, 5, 5,
+		5, 0, 5, 0, 0, 5, 0, 5, 5, 5, 7, 0, 7, 7, 5, 5, 5, 3, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5,
+		7, 7, 5, 5, 5, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 7, 5, 5, 5, 5, 5, 5, 7, 5, 7, 7, 5, 7, 5,
+		5, 7, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 7, 5, 5, 5, 5, 0, 0, 7, 7, 7, 7, 5, 5, 7, 5,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 7, 7, 5, 7, 5,
+		5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 5, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 5,
+		0, 0, 5, 5, 5, 5, 7, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7, 5, 5, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 7, 7, 7, 7, 7, 0, 7, 7, 0, 0, 5, 5, 5, 5, 3,
+		7, 3, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 5, 5, 5, 5, 0, 0, 5, 5, 7, 7, 7, 7,
+		5, 0, 0, 0, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 7, 0, 5, 5, 5, 5, 0,
+		0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 7, 7, 5, 5, 5, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 3, 3, 3, 3, 3, 3, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7, 5, 5, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 7, 5, 5, 5, 7, 5, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 5, 5, 5, 5, 5, 5, 5, 0, 5, 5, 5, 5, 5, 5, 7, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 0, 7, 5, 5, 5, 5, 5, 5, 5, 7, 5, 5, 7, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 0, 0, 0, 5, 0, 5, 5, 0, 5,
+		5, 5, 5, 5, 5, 5, 3, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 7, 7, 7, 0, 5, 5, 0, 7, 7, 5, 7, 5, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 7, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 3, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7, 7, 5, 5, 5, 5, 5, 0, 0, 0, 7, 7,
+		5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		5, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 7, 7, 7, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 9, 0, 0, 0, 9, 9, 9, 9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7,
+		7, 7, 7, 7, 7, 7, 7, 7, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0,
+		4, 4, 4, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 0, 0, 0, 5, 5, 5, 5, 5, 5, 4, 4, 4, 4, 4, 4, 4, 4, 5, 5, 5, 5, 5,
+		5, 5, 5, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5,
+		0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		5, 5, 5, 5, 5, 5, 5, 0, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 0, 0, 5, 5, 5, 5, 5,
+		5, 5, 0, 5, 5, 0, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 5, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 5, 5, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 5, 5, 5, 5, 5, 5, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 5, 5, 5, 5,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	},
 }
@@ -0,0 +1,63 @@
+package grapheme
+
+// This file has been generated -- you probably should NOT EDIT IT !
+// Compiled from the rules of Breaker by grapheme/internal/dfagenerator.
+
+import "github.com/npillmayer/uax/internal/dfa"
+
+// graphemeDFA is a deterministic automaton with 14 states over 19 input symbols.
+var graphemeDFA = &dfa.Table{
+	Symbols: 19,
+	EOT:     18,
+	Next: []uint16{
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 11, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 4, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 9, 5, 4, 6, 7, 8, 7, 8, 12, 9, 10, 4, 9, 9, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 10, 9, 10, 4, 13, 10, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 4, 9, 10, 4, 4, 4, 0,
+		1, 2, 3, 2, 4, 5, 4, 6, 7, 8, 7, 8, 13, 9, 10, 4, 13, 13, 0,
+	},
+	Before: []uint8{
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		2, 3, 2, 4, 5, 2, 5, 2, 2, 2, 2, 2, 5, 2, 2, 2, 5, 5, 2,
+		6, 4, 6, 4, 5, 6, 5, 6, 6, 6, 6, 6, 5, 6, 6, 6, 5, 5, 6,
+		7, 2, 3, 2, 8, 3, 8, 3, 3, 3, 3, 3, 8, 3, 3, 3, 8, 8, 7,
+		7, 2, 7, 2, 3, 7, 3, 7, 7, 7, 7, 7, 3, 7, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 3, 3, 7, 7, 7, 7, 7, 3, 7, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 7, 3, 3, 3, 7, 3, 3, 3, 7, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 7, 3, 7, 3, 3, 7, 7, 3, 7, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 7, 3, 7, 7, 3, 7, 7, 3, 7, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 7, 3, 7, 7, 7, 7, 7, 3, 7, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 7, 3, 7, 7, 7, 7, 7, 3, 7, 7, 7, 3, 3, 7,
+		9, 10, 9, 10, 11, 9, 11, 9, 9, 9, 9, 9, 11, 9, 9, 9, 11, 11, 9,
+		7, 2, 7, 2, 3, 7, 3, 7, 7, 7, 7, 7, 3, 3, 7, 7, 3, 3, 7,
+		7, 2, 7, 2, 3, 7, 3, 7, 7, 7, 7, 7, 3, 7, 3, 7, 3, 3, 7,
+	},
+	Resolve: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	Values: []int{
+		0, -20000, 10000, -40000, -10000, -20010, -10, 20000, -40010, -60000, -30000,
+	},
+}
@@ -0,0 +1,420 @@
+package grapheme
+
+// This file has been generated -- you probably should NOT EDIT IT !
+// 
+// BSD License, Copyright (c) 2018, Norbert Pillmayer (norbert@pillmayer.com)
+
+import (
+    "unicode"
+
+    "golang.org/x/text/unicode/rangetable"
+)
+
+// Range tables for property Indic_Conjunct_Break (InCB), needed for rule GB9c.
+// Will be initialized with SetupGraphemeClasses().
+var inCBLinker, inCBConsonant, inCBExtend *unicode.RangeTable
+
+func setupInCBClasses() {
+
+    // Range for InCB=Linker
+    inCBLinker = rangetable.New('\u094d', '\u09cd', '\u0acd', '\u0b4d', '\u0c4d', '\u0d4d', 
+    '\u1039', '\u17d2', '\u1a60', '\u1b44', '\u1bab', '\ua9c0', '\uaaf6', '\U00010a3f', 
+    '\U00011133', '\U000113d0', '\U0001193e', '\U00011a47', '\U00011a99', '\U00011f42')
+
+    // Range for InCB=Consonant
+    inCBConsonant = rangetable.New('\u0915', '\u0916', '\u0917', '\u0918', '\u0919', '\u091a', 
+    '\u091b', '\u091c', '\u091d', '\u091e', '\u091f', '\u0920', '\u0921', '\u0922', 
+    '\u0923', '\u0924', '\u0925', '\u0926', '\u0927', '\u0928', '\u0929', '\u092a', 
+    '\u092b', '\u092c', '\u092d', '\u092e', '\u092f', '\u0930', '\u0931', '\u0932', 
+    '\u0933', '\u0934', '\u0935', '\u0936', '\u0937', '\u0938', '\u0939', '\u0958', 
+    '\u0959', '\u095a', '\u095b', '\u095c', '\u095d', '\u095e', '\u095f', '\u0978', 
+    '\u0979', '\u097a', '\u097b', '\u097c', '\u097d', '\u097e', '\u097f', '\u0995', 
+    '\u0996', '\u0997', '\u0998', '\u0999', '\u099a', '\u099b', '\u099c', '\u099d', 
+    '\u099e', '\u099f', '\u09a0', '\u09a1', '\u09a2', '\u09a3', '\u09a4', '\u09a5', 
+    '\u09a6', '\u09a7', '\u09a8', '\u09aa', '\u09ab', '\u09ac', '\u09ad', '\u09ae', 
+    '\u09af', '\u09b0', '\u09b2', '\u09b6', '\u09b7', '\u09b8', '\u09b9', '\u09dc', 
+    '\u09dd', '\u09df', '\u09f0', '\u09f1', '\u0a95', '\u0a96', '\u0a97', '\u0a98', 
+    '\u0a99', '\u0a9a', '\u0a9b', '\u0a9c', '\u0a9d', '\u0a9e', '\u0a9f', '\u0aa0', 
+    '\u0aa1', '\u0aa2', '\u0aa3', '\u0aa4', '\u0aa5', '\u0aa6', '\u0aa7', '\u0aa8', 
+    '\u0aaa', '\u0aab', '\u0aac', '\u0aad', '\u0aae', '\u0aaf', '\u0ab0', '\u0ab2', 
+    '\u0ab3', '\u0ab5', '\u0ab6', '\u0ab7', '\u0ab8', '\u0ab9', '\u0af9', '\u0b15', 
+    '\u0b16', '\u0b17', '\u0b18', '\u0b19', '\u0b1a', '\u0b1b', '\u0b1c', '\u0b1d', 
+    '\u0b1e', '\u0b1f', '\u0b20', '\u0b21', '\u0b22', '\u0b23', '\u0b24', '\u0b25', 
+    '\u0b26', '\u0b27', '\u0b28', '\u0b2a', '\u0b2b', '\u0b2c', '\u0b2d', '\u0b2e', 
+    '\u0b2f', '\u0b30', '\u0b32', '\u0b33', '\u0b35', '\u0b36', '\u0b37', '\u0b38', 
+    '\u0b39', '\u0b5c', '\u0b5d', '\u0b5f', '\u0b71', '\u0c15', '\u0c16', '\u0c17', 
+    '\u0c18', '\u0c19', '\u0c1a', '\u0c1b', '\u0c1c', '\u0c1d', '\u0c1e', '\u0c1f', 
+    '\u0c20', '\u0c21', '\u0c22', '\u0c23', '\u0c24', '\u0c25', '\u0c26', '\u0c27', 
+    '\u0c28', '\u0c2a', '\u0c2b', '\u0c2c', '\u0c2d', '\u0c2e', '\u0c2f', '\u0c30', 
+    '\u0c31', '\u0c32', '\u0c33', '\u0c34', '\u0c35', '\u0c36', '\u0c37', '\u0c38', 
+    '\u0c39', '\u0c58', '\u0c59', '\u0c5a', '\u0d15', '\u0d16', '\u0d17', '\u0d18', 
+    '\u0d19', '\u0d1a', '\u0d1b', '\u0d1c', '\u0d1d', '\u0d1e', '\u0d1f', '\u0d20', 
+    '\u0d21', '\u0d22', '\u0d23', '\u0d24', '\u0d25', '\u0d26', '\u0d27', '\u0d28', 
+    '\u0d29', '\u0d2a', '\u0d2b', '\u0d2c', '\u0d2d', '\u0d2e', '\u0d2f', '\u0d30', 
+    '\u0d31', '\u0d32', '\u0d33', '\u0d34', '\u0d35', '\u0d36', '\u0d37', '\u0d38', 
+    '\u0d39', '\u0d3a', '\u1000', '\u1001', '\u1002', '\u1003', '\u1004', '\u1005', 
+    '\u1006', '\u1007', '\u1008', '\u1009', '\u100a', '\u100b', '\u100c', '\u100d', 
+    '\u100e', '\u100f', '\u1010', '\u1011', '\u1012', '\u1013', '\u1014', '\u1015', 
+    '\u1016', '\u1017', '\u1018', '\u1019', '\u101a', '\u101b', '\u101c', '\u101d', 
+    '\u101e', '\u101f', '\u1020', '\u1021', '\u1022', '\u1023', '\u1024', '\u1025', 
+    '\u1026', '\u1027', '\u1028', '\u1029', '\u102a', '\u103f', '\u1050', '\u1051', 
+    '\u1052', '\u1053', '\u1054', '\u1055', '\u105a', '\u105b', '\u105c', '\u105d', 
+    '\u1061', '\u1065', '\u1066', '\u106e', '\u106f', '\u1070', '\u1075', '\u1076', 
+    '\u1077', '\u1078', '\u1079', '\u107a', '\u107b', '\u107c', '\u107d', '\u107e', 
+    '\u107f', '\u1080', '\u1081', '\u108e', '\u1780', '\u1781', '\u1782', '\u1783', 
+    '\u1784', '\u1785', '\u1786', '\u1787', '\u1788', '\u1789', '\u178a', '\u178b', 
+    '\u178c', '\u178d', '\u178e', '\u178f', '\u1790', '\u1791', '\u1792', '\u1793', 
+    '\u1794', '\u1795', '\u1796', '\u1797', '\u1798', '\u1799', '\u179a', '\u179b', 
+    '\u179c', '\u179d', '\u179e', '\u179f', '\u17a0', '\u17a1', '\u17a2', '\u17a3', 
+    '\u17a4', '\u17a5', '\u17a6', '\u17a7', '\u17a8', '\u17a9', '\u17aa', '\u17ab', 
+    '\u17ac', '\u17ad', '\u17ae', '\u17af', '\u17b0', '\u17b1', '\u17b2', '\u17b3', 
+    '\u1a20', '\u1a21', '\u1a22', '\u1a23', '\u1a24', '\u1a25', '\u1a26', '\u1a27', 
+    '\u1a28', '\u1a29', '\u1a2a', '\u1a2b', '\u1a2c', '\u1a2d', '\u1a2e', '\u1a2f', 
+    '\u1a30', '\u1a31', '\u1a32', '\u1a33', '\u1a34', '\u1a35', '\u1a36', '\u1a37', 
+    '\u1a38', '\u1a39', '\u1a3a', '\u1a3b', '\u1a3c', '\u1a3d', '\u1a3e', '\u1a3f', 
+    '\u1a40', '\u1a41', '\u1a42', '\u1a43', '\u1a44', '\u1a45', '\u1a46', '\u1a47', 
+    '\u1a48', '\u1a49', '\u1a4a', '\u1a4b', '\u1a4c', '\u1a4d', '\u1a4e', '\u1a4f', 
+    '\u1a50', '\u1a51', '\u1a52', '\u1a53', '\u1a54', '\u1b0b', '\u1b0c', '\u1b13', 
+    '\u1b14', '\u1b15', '\u1b16', '\u1b17', '\u1b18', '\u1b19', '\u1b1a', '\u1b1b', 
+    '\u1b1c', '\u1b1d', '\u1b1e', '\u1b1f', '\u1b20', '\u1b21', '\u1b22', '\u1b23', 
+    '\u1b24', '\u1b25', '\u1b26', '\u1b27', '\u1b28', '\u1b29', '\u1b2a', '\u1b2b', 
+    '\u1b2c', '\u1b2d', '\u1b2e', '\u1b2f', '\u1b30', '\u1b31', '\u1b32', '\u1b33', 
+    '\u1b45', '\u1b46', '\u1b47', '\u1b48', '\u1b49', '\u1b4a', '\u1b4b', '\u1b4c', 
+    '\u1b83', '\u1b84', '\u1b85', '\u1b86', '\u1b87', '\u1b88', '\u1b89', '\u1b8a', 
+    '\u1b8b', '\u1b8c', '\u1b8d', '\u1b8e', '\u1b8f', '\u1b90', '\u1b91', '\u1b92', 
+    '\u1b93', '\u1b94', '\u1b95', '\u1b96', '\u1b97', '\u1b98', '\u1b99', '\u1b9a', 
+    '\u1b9b', '\u1b9c', '\u1b9d', '\u1b9e', '\u1b9f', '\u1ba0', '\u1bae', '\u1baf', 
+    '\u1bbb', '\u1bbc', '\u1bbd', '\ua989', '\ua98a', '\ua98b', '\ua98f', '\ua990', 
+    '\ua991', '\ua992', '\ua993', '\ua994', '\ua995', '\ua996', '\ua997', '\ua998', 
+    '\ua999', '\ua99a', '\ua99b', '\ua99c', '\ua99d', '\ua99e', '\ua99f', '\ua9a0', 
+    '\ua9a1', '\ua9a2', '\ua9a3', '\ua9a4', '\ua9a5', '\ua9a6', '\ua9a7', '\ua9a8', 
+    '\ua9a9', '\ua9aa', '\ua9ab', '\ua9ac', '\ua9ad', '\ua9ae', '\ua9af', '\ua9b0', 
+    '\ua9b1', '\ua9b2', '\ua9e0', '\ua9e1', '\ua9e2', '\ua9e3', '\ua9e4', '\ua9e7', 
+    '\ua9e8', '\ua9e9', '\ua9ea', '\ua9eb', '\ua9ec', '\ua9ed', '\ua9ee', '\ua9ef', 
+    '\ua9fa', '\ua9fb', '\ua9fc', '\ua9fd', '\ua9fe', '\uaa60', '\uaa61', '\uaa62', 
+    '\uaa63', '\uaa64', '\uaa65', '\uaa66', '\uaa67', '\uaa68', '\uaa69', '\uaa6a', 
+    '\uaa6b', '\uaa6c', '\uaa6d', '\uaa6e', '\uaa6f', '\uaa71', '\uaa72', '\uaa73', 
+    '\uaa7a', '\uaa7e', '\uaa7f', '\uaae0', '\uaae1', '\uaae2', '\uaae3', '\uaae4', 
+    '\uaae5', '\uaae6', '\uaae7', '\uaae8', '\uaae9', '\uaaea', '\uabc0', '\uabc1', 
+    '\uabc2', '\uabc3', '\uabc4', '\uabc5', '\uabc6', '\uabc7', '\uabc8', '\uabc9', 
+    '\uabca', '\uabcb', '\uabcc', '\uabcd', '\uabce', '\uabcf', '\uabd0', '\uabd1', 
+    '\uabd2', '\uabd3', '\uabd4', '\uabd5', '\uabd6', '\uabd7', '\uabd8', '\uabd9', 
+    '\uabda', '\U00010a00', '\U00010a10', '\U00010a11', '\U00010a12', '\U00010a13', '\U00010a15', '\U00010a16', 
+    '\U00010a17', '\U00010a19', '\U00010a1a', '\U00010a1b', '\U00010a1c', '\U00010a1d', '\U00010a1e', '\U00010a1f', 
+    '\U00010a20', '\U00010a21', '\U00010a22', '\U00010a23', '\U00010a24', '\U00010a25', '\U00010a26', '\U00010a27', 
+    '\U00010a28', '\U00010a29', '\U00010a2a', '\U00010a2b', '\U00010a2c', '\U00010a2d', '\U00010a2e', '\U00010a2f', 
+    '\U00010a30', '\U00010a31', '\U00010a32', '\U00010a33', '\U00010a34', '\U00010a35', '\U00011103', '\U00011104', 
+    '\U00011105', '\U00011106', '\U00011107', '\U00011108', '\U00011109', '\U0001110a', '\U0001110b', '\U0001110c', 
+    '\U0001110d', '\U0001110e', '\U0001110f', '\U00011110', '\U00011111', '\U00011112', '\U00011113', '\U00011114', 
+    '\U00011115', '\U00011116', '\U00011117', '\U00011118', '\U00011119', '\U0001111a', '\U0001111b', '\U0001111c', 
+    '\U0001111d', '\U0001111e', '\U0001111f', '\U00011120', '\U00011121', '\U00011122', '\U00011123', '\U00011124', 
+    '\U00011125', '\U00011126', '\U00011144', '\U00011147', '\U00011380', '\U00011381', '\U00011382', '\U00011383', 
+    '\U00011384', '\U00011385', '\U00011386', '\U00011387', '\U00011388', '\U00011389', '\U0001138b', '\U0001138e', 
+    '\U00011390', '\U00011391', '\U00011392', '\U00011393', '\U00011394', '\U00011395', '\U00011396', '\U00011397', 
+    '\U00011398', '\U00011399', '\U0001139a', '\U0001139b', '\U0001139c', '\U0001139d', '\U0001139e', '\U0001139f', 
+    '\U000113a0', '\U000113a1', '\U000113a2', '\U000113a3', '\U000113a4', '\U000113a5', '\U000113a6', '\U000113a7', 
+    '\U000113a8', '\U000113a9', '\U000113aa', '\U000113ab', '\U000113ac', '\U000113ad', '\U000113ae', '\U000113af', 
+    '\U000113b0', '\U000113b1', '\U000113b2', '\U000113b3', '\U000113b4', '\U000113b5', '\U00011900', '\U00011901', 
+    '\U00011902', '\U00011903', '\U00011904', '\U00011905', '\U00011906', '\U00011909', '\U0001190c', '\U0001190d', 
+    '\U0001190e', '\U0001190f', '\U00011910', '\U00011911', '\U00011912', '\U00011913', '\U00011915', '\U00011916', 
+    '\U00011918', '\U00011919', '\U0001191a', '\U0001191b', '\U0001191c', '\U0001191d', '\U0001191e', '\U0001191f', 
+    '\U00011920', '\U00011921', '\U00011922', '\U00011923', '\U00011924', '\U00011925', '\U00011926', '\U00011927', 
+    '\U00011928', '\U00011929', '\U0001192a', '\U0001192b', '\U0001192c', '\U0001192d', '\U0001192e', '\U0001192f', 
+    '\U00011a00', '\U00011a0b', '\U00011a0c', '\U00011a0d', '\U00011a0e', '\U00011a0f', '\U00011a10', '\U00011a11', 
+    '\U00011a12', '\U00011a13', '\U00011a14', '\U00011a15', '\U00011a16', '\U00011a17', '\U00011a18', '\U00011a19', 
+    '\U00011a1a', '\U00011a1b', '\U00011a1c', '\U00011a1d', '\U00011a1e', '\U00011a1f', '\U00011a20', '\U00011a21', 
+    '\U00011a22', '\U00011a23', '\U00011a24', '\U00011a25', '\U00011a26', '\U00011a27', '\U00011a28', '\U00011a29', 
+    '\U00011a2a', '\U00011a2b', '\U00011a2c', '\U00011a2d', '\U00011a2e', '\U00011a2f', '\U00011a30', '\U00011a31', 
+    '\U00011a32', '\U00011a50', '\U00011a5c', '\U00011a5d', '\U00011a5e', '\U00011a5f', '\U00011a60', '\U00011a61', 
+    '\U00011a62', '\U00011a63', '\U00011a64', '\U00011a65', '\U00011a66', '\U00011a67', '\U00011a68', '\U00011a69', 
+    '\U00011a6a', '\U00011a6b', '\U00011a6c', '\U00011a6d', '\U00011a6e', '\U00011a6f', '\U00011a70', '\U00011a71', 
+    '\U00011a72', '\U00011a73', '\U00011a74', '\U00011a75', '\U00011a76', '\U00011a77', '\U00011a78', '\U00011a79', 
+    '\U00011a7a', '\U00011a7b', '\U00011a7c', '\U00011a7d', '\U00011a7e', '\U00011a7f', '\U00011a80', '\U00011a81', 
+    '\U00011a82', '\U00011a83', '\U00011f04', '\U00011f05', '\U00011f06', '\U00011f07', '\U00011f08', '\U00011f09', 
+    '\U00011f0a', '\U00011f0b', '\U00011f0c', '\U00011f0d', '\U00011f0e', '\U00011f0f', '\U00011f10', '\U00011f12', 
+    '\U00011f13', '\U00011f14', '\U00011f15', '\U00011f16', '\U00011f17', '\U00011f18', '\U00011f19', '\U00011f1a', 
+    '\U00011f1b', '\U00011f1c', '\U00011f1d', '\U00011f1e', '\U00011f1f', '\U00011f20', '\U00011f21', '\U00011f22', 
+    '\U00011f23', '\U00011f24', '\U00011f25', '\U00011f26', '\U00011f27', '\U00011f28', '\U00011f29', '\U00011f2a', 
+    '\U00011f2b', '\U00011f2c', '\U00011f2d', '\U00011f2e', '\U00011f2f', '\U00011f30', '\U00011f31', '\U00011f32', 
+    '\U00011f33')
+
+    // Range for InCB=Extend
+    inCBExtend = rangetable.New('\u0300', '\u0301', '\u0302', '\u0303', '\u0304', '\u0305', 
+    '\u0306', '\u0307', '\u0308', '\u0309', '\u030a', '\u030b', '\u030c', '\u030d', 
+    '\u030e', '\u030f', '\u0310', '\u0311', '\u0312', '\u0313', '\u0314', '\u0315', 
+    '\u0316', '\u0317', '\u0318', '\u0319', '\u031a', '\u031b', '\u031c', '\u031d', 
+    '\u031e', '\u031f', '\u0320', '\u0321', '\u0322', '\u0323', '\u0324', '\u0325', 
+    '\u0326', '\u0327', '\u0328', '\u0329', '\u032a', '\u032b', '\u032c', '\u032d', 
+    '\u032e', '\u032f', '\u0330', '\u0331', '\u0332', '\u0333', '\u0334', '\u0335', 
+    '\u0336', '\u0337', '\u0338', '\u0339', '\u033a', '\u033b', '\u033c', '\u033d', 
+    '\u033e', '\u033f', '\u0340', '\u0341', '\u0342', '\u0343', '\u0344', '\u0345', 
+    '\u0346', '\u0347', '\u0348', '\u0349', '\u034a', '\u034b', '\u034c', '\u034d', 
+    '\u034e', '\u034f', '\u0350', '\u0351', '\u0352', '\u0353', '\u0354', '\u0355', 
+    '\u0356', '\u0357', '\u0358', '\u0359', '\u035a', '\u035b', '\u035c', '\u035d', 
+    '\u035e', '\u035f', '\u0360', '\u0361', '\u0362', '\u0363', '\u0364', '\u0365', 
+    '\u0366', '\u0367', '\u0368', '\u0369', '\u036a', '\u036b', '\u036c', '\u036d', 
+    '\u036e', '\u036f', '\u0483', '\u0484', '\u0485', '\u0486', '\u0487', '\u0488', 
+    '\u0489', '\u0591', '\u0592', '\u0593', '\u0594', '\u0595', '\u0596', '\u0597', 
+    '\u0598', '\u0599', '\u059a', '\u059b', '\u059c', '\u059d', '\u059e', '\u059f', 
+    '\u05a0', '\u05a1', '\u05a2', '\u05a3', '\u05a4', '\u05a5', '\u05a6', '\u05a7', 
+    '\u05a8', '\u05a9', '\u05aa', '\u05ab', '\u05ac', '\u05ad', '\u05ae', '\u05af', 
+    '\u05b0', '\u05b1', '\u05b2', '\u05b3', '\u05b4', '\u05b5', '\u05b6', '\u05b7', 
+    '\u05b8', '\u05b9', '\u05ba', '\u05bb', '\u05bc', '\u05bd', '\u05bf', '\u05c1', 
+    '\u05c2', '\u05c4', '\u05c5', '\u05c7', '\u0610', '\u0611', '\u0612', '\u0613', 
+    '\u0614', '\u0615', '\u0616', '\u0617', '\u0618', '\u0619', '\u061a', '\u064b', 
+    '\u064c', '\u064d', '\u064e', '\u064f', '\u0650', '\u0651', '\u0652', '\u0653', 
+    '\u0654', '\u0655', '\u0656', '\u0657', '\u0658', '\u0659', '\u065a', '\u065b', 
+    '\u065c', '\u065d', '\u065e', '\u065f', '\u0670', '\u06d6', '\u06d7', '\u06d8', 
+    '\u06d9', '\u06da', '\u06db', '\u06dc', '\u06df', '\u06e0', '\u06e1', '\u06e2', 
+    '\u06e3', '\u06e4', '\u06e7', '\u06e8', '\u06ea', '\u06eb', '\u06ec', '\u06ed', 
+    '\u0711', '\u0730', '\u0731', '\u0732', '\u0733', '\u0734', '\u0735', '\u0736', 
+    '\u0737', '\u0738', '\u0739', '\u073a', '\u073b', '\u073c', '\u073d', '\u073e', 
+    '\u073f', '\u0740', '\u0741', '\u0742', '\u0743', '\u0744', '\u0745', '\u0746', 
+    '\u0747', '\u0748', '\u0749', '\u074a', '\u07a6', '\u07a7', '\u07a8', '\u07a9', 
+    '\u07aa', '\u07ab', '\u07ac', '\u07ad', '\u07ae', '\u07af', '\u07b0', '\u07eb', 
+    '\u07ec', '\u07ed', '\u07ee', '\u07ef', '\u07f0', '\u07f1', '\u07f2', '\u07f3', 
+    '\u07fd', '\u0816', '\u0817', '\u0818', '\u0819', '\u081b', '\u081c', '\u081d', 
+    '\u081e', '\u081f', '\u0820', '\u0821', '\u0822', '\u0823', '\u0825', '\u0826', 
+    '\u0827', '\u0829', '\u082a', '\u082b', '\u082c', '\u082d', '\u0859', '\u085a', 
+    '\u085b', '\u0897', '\u0898', '\u0899', '\u089a', '\u089b', '\u089c', '\u089d', 
+    '\u089e', '\u089f', '\u08ca', '\u08cb', '\u08cc', '\u08cd', '\u08ce', '\u08cf', 
+    '\u08d0', '\u08d1', '\u08d2', '\u08d3', '\u08d4', '\u08d5', '\u08d6', '\u08d7', 
+    '\u08d8', '\u08d9', '\u08da', '\u08db', '\u08dc', '\u08dd', '\u08de', '\u08df', 
+    '\u08e0', '\u08e1', '\u08e3', '\u08e4', '\u08e5', '\u08e6', '\u08e7', '\u08e8', 
+    '\u08e9', '\u08ea', '\u08eb', '\u08ec', '\u08ed', '\u08ee', '\u08ef', '\u08f0', 
+    '\u08f1', '\u08f2', '\u08f3', '\u08f4', '\u08f5', '\u08f6', '\u08f7', '\u08f8', 
+    '\u08f9', '\u08fa', '\u08fb', '\u08fc', '\u08fd', '\u08fe', '\u08ff', '\u0900', 
+    '\u0901', '\u0902', '\u093a', '\u093c', '\u0941', '\u0942', '\u0943', '\u0944', 
+    '\u0945', '\u0946', '\u0947', '\u0948', '\u0951', '\u0952', '\u0953', '\u0954', 
+    '\u0955', '\u0956', '\u0957', '\u0962', '\u0963', '\u0981', '\u09bc', '\u09be', 
+    '\u09c1', '\u09c2', '\u09c3', '\u09c4', '\u09d7', '\u09e2', '\u09e3', '\u09fe', 
+    '\u0a01', '\u0a02', '\u0a3c', '\u0a41', '\u0a42', '\u0a47', '\u0a48', '\u0a4b', 
+    '\u0a4c', '\u0a4d', '\u0a51', '\u0a70', '\u0a71', '\u0a75', '\u0a81', '\u0a82', 
+    '\u0abc', '\u0ac1', '\u0ac2', '\u0ac3', '\u0ac4', '\u0ac5', '\u0ac7', '\u0ac8', 
+    '\u0ae2', '\u0ae3', '\u0afa', '\u0afb', '\u0afc', '\u0afd', '\u0afe', '\u0aff', 
+    '\u0b01', '\u0b3c', '\u0b3e', '\u0b3f', '\u0b41', '\u0b42', '\u0b43', '\u0b44', 
+    '\u0b55', '\u0b56', '\u0b57', '\u0b62', '\u0b63', '\u0b82', '\u0bbe', '\u0bc0', 
+    '\u0bcd', '\u0bd7', '\u0c00', '\u0c04', '\u0c3c', '\u0c3e', '\u0c3f', '\u0c40', 
+    '\u0c46', '\u0c47', '\u0c48', '\u0c4a', '\u0c4b', '\u0c4c', '\u0c55', '\u0c56', 
+    '\u0c62', '\u0c63', '\u0c81', '\u0cbc', '\u0cbf', '\u0cc0', '\u0cc2', '\u0cc6', 
+    '\u0cc7', '\u0cc8', '\u0cca', '\u0ccb', '\u0ccc', '\u0ccd', '\u0cd5', '\u0cd6', 
+    '\u0ce2', '\u0ce3', '\u0d00', '\u0d01', '\u0d3b', '\u0d3c', '\u0d3e', '\u0d41', 
+    '\u0d42', '\u0d43', '\u0d44', '\u0d57', '\u0d62', '\u0d63', '\u0d81', '\u0dca', 
+    '\u0dcf', '\u0dd2', '\u0dd3', '\u0dd4', '\u0dd6', '\u0ddf', '\u0e31', '\u0e34', 
+    '\u0e35', '\u0e36', '\u0e37', '\u0e38', '\u0e39', '\u0e3a', '\u0e47', '\u0e48', 
+    '\u0e49', '\u0e4a', '\u0e4b', '\u0e4c', '\u0e4d', '\u0e4e', '\u0eb1', '\u0eb4', 
+    '\u0eb5', '\u0eb6', '\u0eb7', '\u0eb8', '\u0eb9', '\u0eba', '\u0ebb', '\u0ebc', 
+    '\u0ec8', '\u0ec9', '\u0eca', '\u0ecb', '\u0ecc', '\u0ecd', '\u0ece', '\u0f18', 
+    '\u0f19', '\u0f35', '\u0f37', '\u0f39', '\u0f71', '\u0f72', '\u0f73', '\u0f74', 
+    '\u0f75', '\u0f76', '\u0f77', '\u0f78', '\u0f79', '\u0f7a', '\u0f7b', '\u0f7c', 
+    '\u0f7d', '\u0f7e', '\u0f80', '\u0f81', '\u0f82', '\u0f83', '\u0f84', '\u0f86', 
+    '\u0f87', '\u0f8d', '\u0f8e', '\u0f8f', '\u0f90', '\u0f91', '\u0f92', '\u0f93', 
+    '\u0f94', '\u0f95', '\u0f96', '\u0f97', '\u0f99', '\u0f9a', '\u0f9b', '\u0f9c', 
+    '\u0f9d', '\u0f9e', '\u0f9f', '\u0fa0', '\u0fa1', '\u0fa2', '\u0fa3', '\u0fa4', 
+    '\u0fa5', '\u0fa6', '\u0fa7', '\u0fa8', '\u0fa9', '\u0faa', '\u0fab', '\u0fac', 
+    '\u0fad', '\u0fae', '\u0faf', '\u0fb0', '\u0fb1', '\u0fb2', '\u0fb3', '\u0fb4', 
+    '\u0fb5', '\u0fb6', '\u0fb7', '\u0fb8', '\u0fb9', '\u0fba', '\u0fbb', '\u0fbc', 
+    '\u0fc6', '\u102d', '\u102e', '\u102f', '\u1030', '\u1032', '\u1033', '\u1034', 
+    '\u1035', '\u1036', '\u1037', '\u103a', '\u103d', '\u103e', '\u1058', '\u1059', 
+    '\u105e', '\u105f', '\u1060', '\u1071', '\u1072', '\u1073', '\u1074', '\u1082', 
+    '\u1085', '\u1086', '\u108d', '\u109d', '\u135d', '\u135e', '\u135f', '\u1712', 
+    '\u1713', '\u1714', '\u1715', '\u1732', '\u1733', '\u1734', '\u1752', '\u1753', 
+    '\u1772', '\u1773', '\u17b4', '\u17b5', '\u17b7', '\u17b8', '\u17b9', '\u17ba', 
+    '\u17bb', '\u17bc', '\u17bd', '\u17c6', '\u17c9', '\u17ca', '\u17cb', '\u17cc', 
+    '\u17cd', '\u17ce', '\u17cf', '\u17d0', '\u17d1', '\u17d3', '\u17dd', '\u180b', 
+    '\u180c', '\u180d', '\u180f', '\u1885', '\u1886', '\u18a9', '\u1920', '\u1921', 
+    '\u1922', '\u1927', '\u1928', '\u1932', '\u1939', '\u193a', '\u193b', '\u1a17', 
+    '\u1a18', '\u1a1b', '\u1a56', '\u1a58', '\u1a59', '\u1a5a', '\u1a5b', '\u1a5c', 
+    '\u1a5d', '\u1a5e', '\u1a62', '\u1a65', '\u1a66', '\u1a67', '\u1a68', '\u1a69', 
+    '\u1a6a', '\u1a6b', '\u1a6c', '\u1a73', '\u1a74', '\u1a75', '\u1a76', '\u1a77', 
+    '\u1a78', '\u1a79', '\u1a7a', '\u1a7b', '\u1a7c', '\u1a7f', '\u1ab0', '\u1ab1', 
+    '\u1ab2', '\u1ab3', '\u1ab4', '\u1ab5', '\u1ab6', '\u1ab7', '\u1ab8', '\u1ab9', 
+    '\u1aba', '\u1abb', '\u1abc', '\u1abd', '\u1abe', '\u1abf', '\u1ac0', '\u1ac1', 
+    '\u1ac2', '\u1ac3', '\u1ac4', '\u1ac5', '\u1ac6', '\u1ac7', '\u1ac8', '\u1ac9', 
+    '\u1aca', '\u1acb', '\u1acc', '\u1acd', '\u1ace', '\u1acf', '\u1ad0', '\u1ad1', 
+    '\u1ad2', '\u1ad3', '\u1ad4', '\u1ad5', '\u1ad6', '\u1ad7', '\u1ad8', '\u1ad9', 
+    '\u1ada', '\u1adb', '\u1adc', '\u1add', '\u1ae0', '\u1ae1', '\u1ae2', '\u1ae3', 
+    '\u1ae4', '\u1ae5', '\u1ae6', '\u1ae7', '\u1ae8', '\u1ae9', '\u1aea', '\u1aeb', 
+    '\u1b00', '\u1b01', '\u1b02', '\u1b03', '\u1b34', '\u1b35', '\u1b36', '\u1b37', 
+    '\u1b38', '\u1b39', '\u1b3a', '\u1b3b', '\u1b3c', '\u1b3d', '\u1b42', '\u1b43', 
+    '\u1b6b', '\u1b6c', '\u1b6d', '\u1b6e', '\u1b6f', '\u1b70', '\u1b71', '\u1b72', 
+    '\u1b73', '\u1b80', '\u1b81', '\u1ba2', '\u1ba3', '\u1ba4', '\u1ba5', '\u1ba8', 
+    '\u1ba9', '\u1baa', '\u1bac', '\u1bad', '\u1be6', '\u1be8', '\u1be9', '\u1bed', 
+    '\u1bef', '\u1bf0', '\u1bf1', '\u1bf2', '\u1bf3', '\u1c2c', '\u1c2d', '\u1c2e', 
+    '\u1c2f', '\u1c30', '\u1c31', '\u1c32', '\u1c33', '\u1c36', '\u1c37', '\u1cd0', 
+    '\u1cd1', '\u1cd2', '\u1cd4', '\u1cd5', '\u1cd6', '\u1cd7', '\u1cd8', '\u1cd9', 
+    '\u1cda', '\u1cdb', '\u1cdc', '\u1cdd', '\u1cde', '\u1cdf', '\u1ce0', '\u1ce2', 
+    '\u1ce3', '\u1ce4', '\u1ce5', '\u1ce6', '\u1ce7', '\u1ce8', '\u1ced', '\u1cf4', 
+    '\u1cf8', '\u1cf9', '\u1dc0', '\u1dc1', '\u1dc2', '\u1dc3', '\u1dc4', '\u1dc5', 
+    '\u1dc6', '\u1dc7', '\u1dc8', '\u1dc9', '\u1dca', '\u1dcb', '\u1dcc', '\u1dcd', 
+    '\u1dce', '\u1dcf', '\u1dd0', '\u1dd1', '\u1dd2', '\u1dd3', '\u1dd4', '\u1dd5', 
+    '\u1dd6', '\u1dd7', '\u1dd8', '\u1dd9', '\u1dda', '\u1ddb', '\u1ddc', '\u1ddd', 
+    '\u1dde', '\u1ddf', '\u1de0', '\u1de1', '\u1de2', '\u1de3', '\u1de4', '\u1de5', 
+    '\u1de6', '\u1de7', '\u1de8', '\u1de9', '\u1dea', '\u1deb', '\u1dec', '\u1ded', 
+    '\u1dee', '\u1def', '\u1df0', '\u1df1', '\u1df2', '\u1df3', '\u1df4', '\u1df5', 
+    '\u1df6', '\u1df7', '\u1df8', '\u1df9', '\u1dfa', '\u1dfb', '\u1dfc', '\u1dfd', 
+    '\u1dfe', '\u1dff', '\u200d', '\u20d0', '\u20d1', '\u20d2', '\u20d3', '\u20d4', 
+    '\u20d5', '\u20d6', '\u20d7', '\u20d8', '\u20d9', '\u20da', '\u20db', '\u20dc', 
+    '\u20dd', '\u20de', '\u20df', '\u20e0', '\u20e1', '\u20e2', '\u20e3', '\u20e4', 
+    '\u20e5', '\u20e6', '\u20e7', '\u20e8', '\u20e9', '\u20ea', '\u20eb', '\u20ec', 
+    '\u20ed', '\u20ee', '\u20ef', '\u20f0', '\u2cef', '\u2cf0', '\u2cf1', '\u2d7f', 
+    '\u2de0', '\u2de1', '\u2de2', '\u2de3', '\u2de4', '\u2de5', '\u2de6', '\u2de7', 
+    '\u2de8', '\u2de9', '\u2dea', '\u2deb', '\u2dec', '\u2ded', '\u2dee', '\u2def', 
+    '\u2df0', '\u2df1', '\u2df2', '\u2df3', '\u2df4', '\u2df5', '\u2df6', '\u2df7', 
+    '\u2df8', '\u2df9', '\u2dfa', '\u2dfb', '\u2dfc', '\u2dfd', '\u2dfe', '\u2dff', 
+    '\u302a', '\u302b', '\u302c', '\u302d', '\u302e', '\u302f', '\u3099', '\u309a', 
+    '\ua66f', '\ua670', '\ua671', '\ua672', '\ua674', '\ua675', '\ua676', '\ua677', 
+    '\ua678', '\ua679', '\ua67a', '\ua67b', '\ua67c', '\ua67d', '\ua69e', '\ua69f', 
+    '\ua6f0', '\ua6f1', '\ua802', '\ua806', '\ua80b', '\ua825', '\ua826', '\ua82c', 
+    '\ua8c4', '\ua8c5', '\ua8e0', '\ua8e1', '\ua8e2', '\ua8e3', '\ua8e4', '\ua8e5', 
+    '\ua8e6', '\ua8e7', '\ua8e8', '\ua8e9', '\ua8ea', '\ua8eb', '\ua8ec', '\ua8ed', 
+    '\ua8ee', '\ua8ef', '\ua8f0', '\ua8f1', '\ua8ff', '\ua926', '\ua927', '\ua928', 
+    '\ua929', '\ua92a', '\ua92b', '\ua92c', '\ua92d', '\ua947', '\ua948', '\ua949', 
+    '\ua94a', '\ua94b', '\ua94c', '\ua94d', '\ua94e', '\ua94f', '\ua950', '\ua951', 
+    '\ua953', '\ua980', '\ua981', '\ua982', '\ua9b3', '\ua9b6', '\ua9b7', '\ua9b8', 
+    '\ua9b9', '\ua9bc', '\ua9bd', '\ua9e5', '\uaa29', '\uaa2a', '\uaa2b', '\uaa2c', 
+    '\uaa2d', '\uaa2e', '\uaa31', '\uaa32', '\uaa35', '\uaa36', '\uaa43', '\uaa4c', 
+    '\uaa7c', '\uaab0', '\uaab2', '\uaab3', '\uaab4', '\uaab7', '\uaab8', '\uaabe', 
+    '\uaabf', '\uaac1', '\uaaec', '\uaaed', '\uabe5', '\uabe8', '\uabed', '\ufb1e', 
+    '\ufe00', '\ufe01', '\ufe02', '\ufe03', '\ufe04', '\ufe05', '\ufe06', '\ufe07', 
+    '\ufe08', '\ufe09', '\ufe0a', '\ufe0b', '\ufe0c', '\ufe0d', '\ufe0e', '\ufe0f', 
+    '\ufe20', '\ufe21', '\ufe22', '\ufe23', '\ufe24', '\ufe25', '\ufe26', '\ufe27', 
+    '\ufe28', '\ufe29', '\ufe2a', '\ufe2b', '\ufe2c', '\ufe2d', '\ufe2e', '\ufe2f', 
+    '\uff9e', '\uff9f', '\U000101fd', '\U000102e0', '\U00010376', '\U00010377', '\U00010378', '\U00010379', 
+    '\U0001037a', '\U00010a01', '\U00010a02', '\U00010a03', '\U00010a05', '\U00010a06', '\U00010a0c', '\U00010a0d', 
+    '\U00010a0e', '\U00010a0f', '\U00010a38', '\U00010a39', '\U00010a3a', '\U00010ae5', '\U00010ae6', '\U00010d24', 
+    '\U00010d25', '\U00010d26', '\U00010d27', '\U00010d69', '\U00010d6a', '\U00010d6b', '\U00010d6c', '\U00010d6d', 
+    '\U00010eab', '\U00010eac', '\U00010efa', '\U00010efb', '\U00010efc', '\U00010efd', '\U00010efe', '\U00010eff', 
+    '\U00010f46', '\U00010f47', '\U00010f48', '\U00010f49', '\U00010f4a', '\U00010f4b', '\U00010f4c', '\U00010f4d', 
+    '\U00010f4e', '\U00010f4f', '\U00010f50', '\U00010f82', '\U00010f83', '\U00010f84', '\U00010f85', '\U00011001', 
+    '\U00011038', '\U00011039', '\U0001103a', '\U0001103b', '\U0001103c', '\U0001103d', '\U0001103e', '\U0001103f', 
+    '\U00011040', '\U00011041', '\U00011042', '\U00011043', '\U00011044', '\U00011045', '\U00011046', '\U00011070', 
+    '\U00011073', '\U00011074', '\U0001107f', '\U00011080', '\U00011081', '\U000110b3', '\U000110b4', '\U000110b5', 
+    '\U000110b6', '\U000110b9', '\U000110ba', '\U000110c2', '\U00011100', '\U00011101', '\U00011102', '\U00011127', 
+    '\U00011128', '\U00011129', '\U0001112a', '\U0001112b', '\U0001112d', '\U0001112e', '\U0001112f', '\U00011130', 
+    '\U00011131', '\U00011132', '\U00011134', '\U00011173', '\U00011180', '\U00011181', '\U000111b6', '\U000111b7', 
+    '\U000111b8', '\U000111b9', '\U000111ba', '\U000111bb', '\U000111bc', '\U000111bd', '\U000111be', '\U000111c0', 
+    '\U000111c9', '\U000111ca', '\U000111cb', '\U000111cc', '\U000111cf', '\U0001122f', '\U00011230', '\U00011231', 
+    '\U00011234', '\U00011235', '\U00011236', '\U00011237', '\U0001123e', '\U00011241', '\U000112df', '\U000112e3', 
+    '\U000112e4', '\U000112e5', '\U000112e6', '\U000112e7', '\U000112e8', '\U000112e9', '\U000112ea', '\U00011300', 
+    '\U00011301', '\U0001133b', '\U0001133c', '\U0001133e', '\U00011340', '\U0001134d', '\U00011357', '\U00011366', 
+    '\U00011367', '\U00011368', '\U00011369', '\U0001136a', '\U0001136b', '\U0001136c', '\U00011370', '\U00011371', 
+    '\U00011372', '\U00011373', '\U00011374', '\U000113b8', '\U000113bb', '\U000113bc', '\U000113bd', '\U000113be', 
+    '\U000113bf', '\U000113c0', '\U000113c2', '\U000113c5', '\U000113c7', '\U000113c8', '\U000113c9', '\U000113ce', 
+    '\U000113cf', '\U000113d2', '\U000113e1', '\U000113e2', '\U00011438', '\U00011439', '\U0001143a', '\U0001143b', 
+    '\U0001143c', '\U0001143d', '\U0001143e', '\U0001143f', '\U00011442', '\U00011443', '\U00011444', '\U00011446', 
+    '\U0001145e', '\U000114b0', '\U000114b3', '\U000114b4', '\U000114b5', '\U000114b6', '\U000114b7', '\U000114b8', 
+    '\U000114ba', '\U000114bd', '\U000114bf', '\U000114c0', '\U000114c2', '\U000114c3', '\U000115af', '\U000115b2', 
+    '\U000115b3', '\U000115b4', '\U000115b5', '\U000115bc', '\U000115bd', '\U000115bf', '\U000115c0', '\U000115dc', 
+    '\U000115dd', '\U00011633', '\U00011634', '\U00011635', '\U00011636', '\U00011637', '\U00011638', '\U00011639', 
+    '\U0001163a', '\U0001163d', '\U0001163f', '\U00011640', '\U000116ab', '\U000116ad', '\U000116b0', '\U000116b1', 
+    '\U000116b2', '\U000116b3', '\U000116b4', '\U000116b5', '\U000116b6', '\U000116b7', '\U0001171d', '\U0001171f', 
+    '\U00011722', '\U00011723', '\U00011724', '\U00011725', '\U00011727', '\U00011728', '\U00011729', '\U0001172a', 
+    '\U0001172b', '\U0001182f', '\U00011830', '\U00011831', '\U00011832', '\U00011833', '\U00011834', '\U00011835', 
+    '\U00011836', '\U00011837', '\U00011839', '\U0001183a', '\U00011930', '\U0001193b', '\U0001193c', '\U0001193d', 
+    '\U00011943', '\U000119d4', '\U000119d5', '\U000119d6', '\U000119d7', '\U000119da', '\U000119db', '\U000119e0', 
+    '\U00011a01', '\U00011a02', '\U00011a03', '\U00011a04', '\U00011a05', '\U00011a06', '\U00011a07', '\U00011a08', 
+    '\U00011a09', '\U00011a0a', '\U00011a33', '\U00011a34', '\U00011a35', '\U00011a36', '\U00011a37', '\U00011a38', 
+    '\U00011a3b', '\U00011a3c', '\U00011a3d', '\U00011a3e', '\U00011a51', '\U00011a52', '\U00011a53', '\U00011a54', 
+    '\U00011a55', '\U00011a56', '\U00011a59', '\U00011a5a', '\U00011a5b', '\U00011a8a', '\U00011a8b', '\U00011a8c', 
+    '\U00011a8d', '\U00011a8e', '\U00011a8f', '\U00011a90', '\U00011a91', '\U00011a92', '\U00011a93', '\U00011a94', 
+    '\U00011a95', '\U00011a96', '\U00011a98', '\U00011b60', '\U00011b62', '\U00011b63', '\U00011b64', '\U00011b66', 
+    '\U00011c30', '\U00011c31', '\U00011c32', '\U00011c33', '\U00011c34', '\U00011c35', '\U00011c36', '\U00011c38', 
+    '\U00011c39', '\U00011c3a', '\U00011c3b', '\U00011c3c', '\U00011c3d', '\U00011c3f', '\U00011c92', '\U00011c93', 
+    '\U00011c94', '\U00011c95', '\U00011c96', '\U00011c97', '\U00011c98', '\U00011c99', '\U00011c9a', '\U00011c9b', 
+    '\U00011c9c', '\U00011c9d', '\U00011c9e', '\U00011c9f', '\U00011ca0', '\U00011ca1', '\U00011ca2', '\U00011ca3', 
+    '\U00011ca4', '\U00011ca5', '\U00011ca6', '\U00011ca7', '\U00011caa', '\U00011cab', '\U00011cac', '\U00011cad', 
+    '\U00011cae', '\U00011caf', '\U00011cb0', '\U00011cb2', '\U00011cb3', '\U00011cb5', '\U00011cb6', '\U00011d31', 
+    '\U00011d32', '\U00011d33', '\U00011d34', '\U00011d35', '\U00011d36', '\U00011d3a', '\U00011d3c', '\U00011d3d', 
+    '\U00011d3f', '\U00011d40', '\U00011d41', '\U00011d42', '\U00011d43', '\U00011d44', '\U00011d45', '\U00011d47', 
+    '\U00011d90', '\U00011d91', '\U00011d95', '\U00011d97', '\U00011ef3', '\U00011ef4', '\U00011f00', '\U00011f01', 
+    '\U00011f36', '\U00011f37', '\U00011f38', '\U00011f39', '\U00011f3a', '\U00011f40', '\U00011f41', '\U00011f5a', 
+    '\U00013440', '\U00013447', '\U00013448', '\U00013449', '\U0001344a', '\U0001344b', '\U0001344c', '\U0001344d', 
+    '\U0001344e', '\U0001344f', '\U00013450', '\U00013451', '\U00013452', '\U00013453', '\U00013454', '\U00013455', 
+    '\U0001611e', '\U0001611f', '\U00016120', '\U00016121', '\U00016122', '\U00016123', '\U00016124', '\U00016125', 
+    '\U00016126', '\U00016127', '\U00016128', '\U00016129', '\U0001612d', '\U0001612e', '\U0001612f', '\U00016af0', 
+    '\U00016af1', '\U00016af2', '\U00016af3', '\U00016af4', '\U00016b30', '\U00016b31', '\U00016b32', '\U00016b33', 
+    '\U00016b34', '\U00016b35', '\U00016b36', '\U00016f4f', '\U00016f8f', '\U00016f90', '\U00016f91', '\U00016f92', 
+    '\U00016fe4', '\U00016ff0', '\U00016ff1', '\U0001bc9d', '\U0001bc9e', '\U0001cf00', '\U0001cf01', '\U0001cf02', 
+    '\U0001cf03', '\U0001cf04', '\U0001cf05', '\U0001cf06', '\U0001cf07', '\U0001cf08', '\U0001cf09', '\U0001cf0a', 
+    '\U0001cf0b', '\U0001cf0c', '\U0001cf0d', '\U0001cf0e', '\U0001cf0f', '\U0001cf10', '\U0001cf11', '\U0001cf12', 
+    '\U0001cf13', '\U0001cf14', '\U0001cf15', '\U0001cf16', '\U0001cf17', '\U0001cf18', '\U0001cf19', '\U0001cf1a', 
+    '\U0001cf1b', '\U0001cf1c', '\U0001cf1d', '\U0001cf1e', '\U0001cf1f', '\U0001cf20', '\U0001cf21', '\U0001cf22', 
+    '\U0001cf23', '\U0001cf24', '\U0001cf25', '\U0001cf26', '\U0001cf27', '\U0001cf28', '\U0001cf29', '\U0001cf2a', 
+    '\U0001cf2b', '\U0001cf2c', '\U0001cf2d', '\U0001cf30', '\U0001cf31', '\U0001cf32', '\U0001cf33', '\U0001cf34', 
+    '\U0001cf35', '\U0001cf36', '\U0001cf37', '\U0001cf38', '\U0001cf39', '\U0001cf3a', '\U0001cf3b', '\U0001cf3c', 
+    '\U0001cf3d', '\U0001cf3e', '\U0001cf3f', '\U0001cf40', '\U0001cf41', '\U0001cf42', '\U0001cf43', '\U0001cf44', 
+    '\U0001cf45', '\U0001cf46', '\U0001d165', '\U0001d166', '\U0001d167', '\U0001d168', '\U0001d169', '\U0001d16d', 
+    '\U0001d16e', '\U0001d16f', '\U0001d170', '\U0001d171', '\U0001d172', '\U0001d17b', '\U0001d17c', '\U0001d17d', 
+    '\U0001d17e', '\U0001d17f', '\U0001d180', '\U0001d181', '\U0001d182', '\U0001d185', '\U0001d186', '\U0001d187', 
+    '\U0001d188', '\U0001d189', '\U0001d18a', '\U0001d18b', '\U0001d1aa', '\U0001d1ab', '\U0001d1ac', '\U0001d1ad', 
+    '\U0001d242', '\U0001d243', '\U0001d244', '\U0001da00', '\U0001da01', '\U0001da02', '\U0001da03', '\U0001da04', 
+    '\U0001da05', '\U0001da06', '\U0001da07', '\U0001da08', '\U0001da09', '\U0001da0a', '\U0001da0b', '\U0001da0c', 
+    '\U0001da0d', '\U0001da0e', '\U0001da0f', '\U0001da10', '\U0001da11', '\U0001da12', '\U0001da13', '\U0001da14', 
+    '\U0001da15', '\U0001da16', '\U0001da17', '\U0001da18', '\U0001da19', '\U0001da1a', '\U0001da1b', '\U0001da1c', 
+    '\U0001da1d', '\U0001da1e', '\U0001da1f', '\U0001da20', '\U0001da21', '\U0001da22', '\U0001da23', '\U0001da24', 
+    '\U0001da25', '\U0001da26', '\U0001da27', '\U0001da28', '\U0001da29', '\U0001da2a', '\U0001da2b', '\U0001da2c', 
+    '\U0001da2d', '\U0001da2e', '\U0001da2f', '\U0001da30', '\U0001da31', '\U0001da32', '\U0001da33', '\U0001da34', 
+    '\U0001da35', '\U0001da36', '\U0001da3b', '\U0001da3c', '\U0001da3d', '\U0001da3e', '\U0001da3f', '\U0001da40', 
+    '\U0001da41', '\U0001da42', '\U0001da43', '\U0001da44', '\U0001da45', '\U0001da46', '\U0001da47', '\U0001da48', 
+    '\U0001da49', '\U0001da4a', '\U0001da4b', '\U0001da4c', '\U0001da4d', '\U0001da4e', '\U0001da4f', '\U0001da50', 
+    '\U0001da51', '\U0001da52', '\U0001da53', '\U0001da54', '\U0001da55', '\U0001da56', '\U0001da57', '\U0001da58', 
+    '\U0001da59', '\U0001da5a', '\U0001da5b', '\U0001da5c', '\U0001da5d', '\U0001da5e', '\U0001da5f', '\U0001da60', 
+    '\U0001da61', '\U0001da62', '\U0001da63', '\U0001da64', '\U0001da65', '\U0001da66', '\U0001da67', '\U0001da68', 
+    '\U0001da69', '\U0001da6a', '\U0001da6b', '\U0001da6c', '\U0001da75', '\U0001da84', '\U0001da9b', '\U0001da9c', 
+    '\U0001da9d', '\U0001da9e', '\U0001da9f', '\U0001daa1', '\U0001daa2', '\U0001daa3', '\U0001daa4', '\U0001daa5', 
+    '\U0001daa6', '\U0001daa7', '\U0001daa8', '\U0001daa9', '\U0001daaa', '\U0001daab', '\U0001daac', '\U0001daad', 
+    '\U0001daae', '\U0001daaf', '\U0001e000', '\U0001e001', '\U0001e002', '\U0001e003', '\U0001e004', '\U0001e005', 
+    '\U0001e006', '\U0001e008', '\U0001e009', '\U0001e00a', '\U0001e00b', '\U0001e00c', '\U0001e00d', '\U0001e00e', 
+    '\U0001e00f', '\U0001e010', '\U0001e011', '\U0001e012', '\U0001e013', '\U0001e014', '\U0001e015', '\U0001e016', 
+    '\U0001e017', '\U0001e018', '\U0001e01b', '\U0001e01c', '\U0001e01d', '\U0001e01e', '\U0001e01f', '\U0001e020', 
+    '\U0001e021', '\U0001e023', '\U0001e024', '\U0001e026', '\U0001e027', '\U0001e028', '\U0001e029', '\U0001e02a', 
+    '\U0001e08f', '\U0001e130', '\U0001e131', '\U0001e132', '\U0001e133', '\U0001e134', '\U0001e135', '\U0001e136', 
+    '\U0001e2ae', '\U0001e2ec', '\U0001e2ed', '\U0001e2ee', '\U0001e2ef', '\U0001e4ec', '\U0001e4ed', '\U0001e4ee', 
+    '\U0001e4ef', '\U0001e5ee', '\U0001e5ef', '\U0001e6e3', '\U0001e6e6', '\U0001e6ee', '\U0001e6ef', '\U0001e6f5', 
+    '\U0001e8d0', '\U0001e8d1', '\U0001e8d2', '\U0001e8d3', '\U0001e8d4', '\U0001e8d5', '\U0001e8d6', '\U0001e944', 
+    '\U0001e945', '\U0001e946', '\U0001e947', '\U0001e948', '\U0001e949', '\U0001e94a', '\U0001f3fb', '\U0001f3fc', 
+    '\U0001f3fd', '\U0001f3fe', '\U0001f3ff', '\U000e0020', '\U000e0021', '\U000e0022', '\U000e0023', '\U000e0024', 
+    '\U000e0025', '\U000e0026', '\U000e0027', '\U000e0028', '\U000e0029', '\U000e002a', '\U000e002b', '\U000e002c', 
+    '\U000e002d', '\U000e002e', '\U000e002f', '\U000e0030', '\U000e0031', '\U000e0032', '\U000e0033', '\U000e0034', 
+    '\U000e0035', '\U000e0036', '\U000e0037', '\U000e0038', '\U000e0039', '\U000e003a', '\U000e003b', '\U000e003c', 
+    '\U000e003d', '\U000e003e', '\U000e003f', '\U000e0040', '\U000e0041', '\U000e0042', '\U000e0043', '\U000e0044', 
+    '\U000e0045', '\U000e0046', '\U000e0047', '\U000e0048', '\U000e0049', '\U000e004a', '\U000e004b', '\U000e004c', 
+    '\U000e004d', '\U000e004e', '\U000e004f', '\U000e0050', '\U000e0051', '\U000e0052', '\U000e0053', '\U000e0054', 
+    '\U000e0055', '\U000e0056', '\U000e0057', '\U000e0058', '\U000e0059', '\U000e005a', '\U000e005b', '\U000e005c', 
+    '\U000e005d', '\U000e005e', '\U000e005f', '\U000e0060', '\U000e0061', '\U000e0062', '\U000e0063', '\U000e0064', 
+    '\U000e0065', '\U000e0066', '\U000e0067', '\U000e0068', '\U000e0069', '\U000e006a', '\U000e006b', '\U000e006c', 
+    '\U000e006d', '\U000e006e', '\U000e006f', '\U000e0070', '\U000e0071', '\U000e0072', '\U000e0073', '\U000e0074', 
+    '\U000e0075', '\U000e0076', '\U000e0077', '\U000e0078', '\U000e0079', '\U000e007a', '\U000e007b', '\U000e007c', 
+    '\U000e007d', '\U000e007e', '\U000e007f', '\U000e0100', '\U000e0101', '\U000e0102', '\U000e0103', '\U000e0104', 
+    '\U000e0105', '\U000e0106', '\U000e0107', '\U000e0108', '\U000e0109', '\U000e010a', '\U000e010b', '\U000e010c', 
+    '\U000e010d', '\U000e010e', '\U000e010f', '\U000e0110', '\U000e0111', '\U000e0112', '\U000e0113', '\U000e0114', 
+    '\U000e0115', '\U000e0116', '\U000e0117', '\U000e0118', '\U000e0119', '\U000e011a', '\U000e011b', '\U000e011c', 
+    '\U000e011d', '\U000e011e', '\U000e011f', '\U000e0120', '\U000e0121', '\U000e0122', '\U000e0123', '\U000e0124', 
+    '\U000e0125', '\U000e0126', '\U000e0127', '\U000e0128', '\U000e0129', '\U000e012a', '\U000e012b', '\U000e012c', 
+    '\U000e012d', '\U000e012e', '\U000e012f', '\U000e0130', '\U000e0131', '\U000e0132', '\U000e0133', '\U000e0134', 
+    '\U000e0135', '\U000e0136', '\U000e0137', '\U000e0138', '\U000e0139', '\U000e013a', '\U000e013b', '\U000e013c', 
+    '\U000e013d', '\U000e013e', '\U000e013f', '\U000e0140', '\U000e0141', '\U000e0142', '\U000e0143', '\U000e0144', 
+    '\U000e0145', '\U000e0146', '\U000e0147', '\U000e0148', '\U000e0149', '\U000e014a', '\U000e014b', '\U000e014c', 
+    '\U000e014d', '\U000e014e', '\U000e014f', '\U000e0150', '\U000e0151', '\U000e0152', '\U000e0153', '\U000e0154', 
+    '\U000e0155', '\U000e0156', '\U000e0157', '\U000e0158', '\U000e0159', '\U000e015a', '\U000e015b', '\U000e015c', 
+    '\U000e015d', '\U000e015e', '\U000e015f', '\U000e0160', '\U000e0161', '\U000e0162', '\U000e0163', '\U000e0164', 
+    '\U000e0165', '\U000e0166', '\U000e0167', '\U000e0168', '\U000e0169', '\U000e016a', '\U000e016b', '\U000e016c', 
+    '\U000e016d', '\U000e016e', '\U000e016f', '\U000e0170', '\U000e0171', '\U000e0172', '\U000e0173', '\U000e0174', 
+    '\U000e0175', '\U000e0176', '\U000e0177', '\U000e0178', '\U000e0179', '\U000e017a', '\U000e017b', '\U000e017c', 
+    '\U000e017d', '\U000e017e', '\U000e017f', '\U000e0180', '\U000e0181', '\U000e0182', '\U000e0183', '\U000e0184', 
+    '\U000e0185', '\U000e0186', '\U000e0187', '\U000e0188', '\U000e0189', '\U000e018a', '\U000e018b', '\U000e018c', 
+    '\U000e018d', '\U000e018e', '\U000e018f', '\U000e0190', '\U000e0191', '\U000e0192', '\U000e0193', '\U000e0194', 
+    '\U000e0195', '\U000e0196', '\U000e0197', '\U000e0198', '\U000e0199', '\U000e019a', '\U000e019b', '\U000e019c', 
+    '\U000e019d', '\U000e019e', '\U000e019f', '\U000e01a0', '\U000e01a1', '\U000e01a2', '\U000e01a3', '\U000e01a4', 
+    '\U000e01a5', '\U000e01a6', '\U000e01a7', '\U000e01a8', '\U000e01a9', '\U000e01aa', '\U000e01ab', '\U000e01ac', 
+    '\U000e01ad', '\U000e01ae', '\U000e01af', '\U000e01b0', '\U000e01b1', '\U000e01b2', '\U000e01b3', '\U000e01b4', 
+    '\U000e01b5', '\U000e01b6', '\U000e01b7', '\U000e01b8', '\U000e01b9', '\U000e01ba', '\U000e01bb', '\U000e01bc', 
+    '\U000e01bd', '\U000e01be', '\U000e01bf', '\U000e01c0', '\U000e01c1', '\U000e01c2', '\U000e01c3', '\U000e01c4', 
+    '\U000e01c5', '\U000e01c6', '\U000e01c7', '\U000e01c8', '\U000e01c9', '\U000e01ca', '\U000e01cb', '\U000e01cc', 
+    '\U000e01cd', '\U000e01ce', '\U000e01cf', '\U000e01d0', '\U000e01d1', '\U000e01d2', '\U000e01d3', '\U000e01d4', 
+    '\U000e01d5', '\U000e01d6', '\U000e01d7', '\U000e01d8', '\U000e01d9', '\U000e01da', '\U000e01db', '\U000e01dc', 
+    '\U000e01dd', '\U000e01de', '\U000e01df', '\U000e01e0', '\U000e01e1', '\U000e01e2', '\U000e01e3', '\U000e01e4', 
+    '\U000e01e5', '\U000e01e6', '\U000e01e7', '\U000e01e8', '\U000e01e9', '\U000e01ea', '\U000e01eb', '\U000e01ec', 
+    '\U000e01ed', '\U000e01ee', '\U000e01ef')
+}
@@ -10,6 +10,11 @@ Classes are generated from a companion file: "GraphemeBreakProperty.txt".
 This is the definite source for UAX#29 code-point classes. The
 generator looks for it in a directory "$GOPATH/etc/".
 
+Rule GB9c (since Unicode 15.1) uses the property Indic_Conjunct_Break (InCB).
+Values Linker, Consonant and Extend are read from "DerivedCoreProperties.txt",
+which has to be located in "$GOPATH/etc/" as well. Versions of this file
+prior to 15.1 do not list the property, and the InCB tables will be empty.
+
 
 Usage
 
@@ -18,8 +23,8 @@ be turned on.
 
    generator [-v]
 
-This creates a file "graphemeclasses.go" in the current directory. It is designed
-to be called from the "grapheme" directory.
+This creates files "graphemeclasses.go" and "incbclasses.go" in the current
+directory. It is designed to be called from the "grapheme" directory.
 
 
 License
@@ -39,11 +44,14 @@ import (
 	"runtime"
 	"text/template"
 	"time"
+	"unicode"
 
 	"os"
 
 	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/npillmayer/uax/internal/classtable"
 	"github.com/npillmayer/uax/internal/ucdparse"
+	"golang.org/x/text/unicode/rangetable"
 )
 
 var logger = log.New(os.Stderr, "UAX#29 generator: ", log.LstdFlags)
@@ -85,6 +93,9 @@ func loadUnicodeGraphemeBreakFile() (map[string][]rune, error) {
 	for parser.Next() {
 		from, to := parser.Token.Range()
 		clstr := parser.Token.Field(1)
+		if clstr == "" { // not a data item, e.g. document root
+			continue
+		}
 		list := gcls[clstr]
 		if list == nil {
 			list = arraylist.New()
@@ -112,6 +123,40 @@ func loadUnicodeGraphemeBreakFile() (map[string][]rune, error) {
 	return runeranges, err
 }
 
+// Load the values of property Indic_Conjunct_Break from DerivedCoreProperties.txt
+func loadIndicConjunctBreak() (map[string][]rune, error) {
+	if verbose {
+		logger.Printf("reading DerivedCoreProperties.txt")
+	}
+	defer timeTrack(time.Now(), "loading DerivedCoreProperties.txt")
+	gopath := os.Getenv("GOPATH")
+	f, err := os.Open(gopath + "/etc/DerivedCoreProperties.txt")
+	if err != nil {
+		fmt.Printf("ERROR loading " + gopath + "/etc/DerivedCoreProperties.txt\n")
+		return nil, err
+	}
+	defer f.Close()
+	parser, err := ucdparse.New(f)
+	if err != nil {
+		return nil, err
+	}
+	runeranges := make(map[string][]rune)
+	for parser.Next() {
+		if parser.Token.Field(1) != "InCB" {
+			continue
+		}
+		value := parser.Token.Field(2)
+		from, to := parser.Token.Range()
+		for r := from; r <= to; r++ {
+			runeranges[value] = append(runeranges[value], r)
+		}
+	}
+	if err = parser.Token.Error; err != nil {
+		log.Fatal(err)
+	}
+	return runeranges, err
+}
+
 // --- Templates --------------------------------------------------------
 
 var header = `package grapheme
@@ -124,6 +169,7 @@ import (
     "strconv"
     "unicode"
 
+    "github.com/npillmayer/uax/internal/classtable"
     "golang.org/x/text/unicode/rangetable"
 )
 `
@@ -178,6 +224,23 @@ func (c GraphemeClass) String() string {
 }
 `
 
+var incbHeader = `package grapheme
+
+// This file has been generated -- you probably should NOT EDIT IT !
+// 
+// BSD License, Copyright (c) 2018, Norbert Pillmayer (norbert@pillmayer.com)
+
+import (
+    "unicode"
+
+    "golang.org/x/text/unicode/rangetable"
+)
+
+// Range tables for property Indic_Conjunct_Break (InCB), needed for rule GB9c.
+// Will be initialized with SetupGraphemeClasses().
+var inCBLinker, inCBConsonant, inCBExtend *unicode.RangeTable
+`
+
 var templateRangeForClass = `{{$i:=0}}{{range .}}{{if notfirst $i}}, {{if modeight $i}}
     {{end}}{{end}}{{$i = inc $i}}{{printf "%+q" .}}{{end}}`
 
@@ -236,6 +299,34 @@ func generateRanges(w *bufio.Writer, codePointLists map[string][]rune) {
 	w.WriteString("}\n")
 }
 
+// generateLookupTable writes a two-stage lookup table for all classes, to be
+// used by the ClassForRune function.
+func generateLookupTable(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate lookup table")
+	classes := make([]*unicode.RangeTable, len(graphemeClassnames))
+	for i, key := range graphemeClassnames {
+		if codepoints, ok := codePointLists[key]; ok {
+			classes[i] = rangetable.New(codepoints...)
+		}
+	}
+	t, err := classtable.Build(classes)
+	checkFatal(err)
+	checkFatal(t.WriteGo(w, "graphemeClassTable"))
+}
+
+func generateInCBRanges(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate InCB range tables")
+	w.WriteString("\nfunc setupInCBClasses() {\n")
+	t := makeTemplate("InCB range", templateRangeForClass)
+	for _, key := range []string{"Linker", "Consonant", "Extend"} {
+		w.WriteString(fmt.Sprintf("\n    // Range for InCB=%s\n", key))
+		w.WriteString(fmt.Sprintf("    inCB%s = rangetable.New(", key))
+		checkFatal(t.Execute(w, codePointLists[key]))
+		w.WriteString(")\n")
+	}
+	w.WriteString("}\n")
+}
+
 func main() {
 	doVerbose := flag.Bool("v", false, "verbose output mode")
 	flag.Parse()
@@ -258,6 +349,17 @@ func main() {
 	t = makeTemplate("Grapheme classes stringer", templateClassStringer)
 	checkFatal(t.Execute(w, graphemeClassnames))
 	generateRanges(w, codePointLists)
+	generateLookupTable(w, codePointLists)
+	w.Flush()
+	//
+	incbLists, err := loadIndicConjunctBreak()
+	checkFatal(err)
+	f2, ioerr := os.Create("incbclasses.go")
+	checkFatal(ioerr)
+	defer f2.Close()
+	w = bufio.NewWriter(f2)
+	w.WriteString(incbHeader)
+	generateInCBRanges(w, incbLists)
 	w.Flush()
 }
 
@@ -0,0 +1,34 @@
+package grapheme
+
+import (
+	"iter"
+
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/segment"
+)
+
+var scanGraphemes = segment.SplitFunc(func() uax.UnicodeBreaker {
+	return NewBreaker(1)
+})
+
+// ScanGraphemes is a split function for a bufio.Scanner that returns each
+// grapheme cluster of the input as a token.
+//
+//   scanner := bufio.NewScanner(r)
+//   scanner.Split(grapheme.ScanGraphemes)
+//
+// See segment.SplitFunc.
+func ScanGraphemes(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scanGraphemes(data, atEOF)
+}
+
+// All returns an iterator over the grapheme clusters of a string:
+//
+//   for g := range grapheme.All("🇩🇪 Übermäßig") {
+//       fmt.Println(g)
+//   }
+//
+// The grapheme clusters are substrings of s.
+func All(s string) iter.Seq[string] {
+	return segment.All(NewBreaker(1), s)
+}
@@ -0,0 +1,204 @@
+package hyphenation
+
+import (
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/uax29"
+)
+
+// === Hyphenation Breaker =======================================
+
+// Breaker is a secondary breaker which adds break opportunities at the
+// hyphenation points of words. It implements the uax.UnicodeBreaker interface and
+// is intended to be used next to a UAX#14 line breaker:
+//
+//	dict, _ := hyphenation.LoadDictionaryFile("hyph-en-us.tex")
+//	segmenter := segment.NewSegmenter(uax14.NewLineWrap(), hyphenation.NewBreaker(dict))
+//
+// Words are words in the sense of UAX#29: runs of letters (word break classes
+// ALetter and Hebrew_Letter) and digits, together with any combining marks
+// (class Extend), apostrophes and other punctuation between letters, and
+// connectors like the underscore (class ExtendNumLet). Thus "don't" and
+// "snake_case" are single words. Punctuation is never a hyphenation point, nor is
+// a position next to it. A word with digits or with an explicit soft hyphen
+// (U+00AD) or another format character is not hyphenated, the latter as it is
+// the author's choice where to hyphenate it.
+//
+// The breaker puts a penalty at every hyphenation point, PenaltyForHyphenation
+// by default. Clients which break lines will have to insert a hyphen when breaking
+// there (see e.g. wrap.TotalFit).
+type Breaker struct {
+	dict      *Dictionary
+	penalty   int              // penalty for breaking at a hyphenation point
+	word      []rune           // current word
+	last      uax29.UAX29Class // class of the last letter, digit or punctuation of word
+	tail      int              // number of runes of trailing punctuation, not yet part of word
+	skip      bool             // word contains a digit or a format character, e.g. a soft hyphen
+	penalties []int            // returned to the segmenter: penalties to insert
+}
+
+// PenaltyForHyphenation is the default penalty for breaking at a hyphenation point
+// (TeX: \hyphenpenalty).
+const PenaltyForHyphenation = 50
+
+// NewBreaker creates a hyphenation breaker for a dictionary. Clients may
+// provide a penalty for breaking at a hyphenation point to replace
+// PenaltyForHyphenation. The penalty is fixed at construction time, thus
+// breakers with different penalties may be used side by side.
+func NewBreaker(dict *Dictionary, penalty ...int) *Breaker {
+	uax29.SetupUAX29Classes()
+	hb := &Breaker{dict: dict, penalty: PenaltyForHyphenation}
+	if len(penalty) > 0 {
+		hb.penalty = penalty[0]
+	}
+	return hb
+}
+
+// CodePointClassFor returns the UAX#29 word code-point class for a rune (= code-point).
+// (Interface uax.UnicodeBreaker)
+func (hb *Breaker) CodePointClassFor(r rune) int {
+	return int(uax29.ClassForRune(r))
+}
+
+// StartRulesFor is part of interface uax.UnicodeBreaker.
+// The hyphenation breaker does not use recognizers, thus this is a no-op.
+func (hb *Breaker) StartRulesFor(r rune, cpClass int) {
+}
+
+// ProceedWithRune is a signal:
+// A new code-point has been read and this breaker receives a message to
+// consume it.
+// (Interface uax.UnicodeBreaker)
+func (hb *Breaker) ProceedWithRune(r rune, cpClass int) {
+	hb.penalties = hb.penalties[:0]
+	c := uax29.UAX29Class(cpClass)
+	if r == uax.EOT {
+		c = uax29.Other
+	}
+	if len(hb.word) > 0 {
+		if hb.continues(c) {
+			hb.word = append(hb.word, r)
+			return
+		}
+		hb.hyphenate()
+	}
+	if isLetter(c) || c == uax29.NumericClass {
+		hb.word = append(hb.word, r)
+		hb.last = c
+		hb.skip = c == uax29.NumericClass
+	}
+}
+
+// continues checks if a code-point of class c continues the current word,
+// following the rules WB4 to WB13b of UAX#29. Punctuation is part of the
+// word only if it is followed by a letter or digit; until then it is counted
+// as the tail of the word.
+func (hb *Breaker) continues(c uax29.UAX29Class) bool {
+	switch c {
+	case uax29.ExtendClass, uax29.ZWJClass, uax29.FormatClass: // WB4
+		if hb.tail > 0 {
+			hb.tail++
+		} else if c == uax29.FormatClass {
+			hb.skip = true
+		}
+		return true
+	case uax29.ALetterClass, uax29.Hebrew_LetterClass, uax29.NumericClass:
+		switch hb.last {
+		case uax29.MidLetterClass:
+			return hb.join(c, isLetter(c)) // WB7
+		case uax29.MidNumClass:
+			return hb.join(c, c == uax29.NumericClass) // WB11
+		case uax29.MidNumLetClass, uax29.Single_QuoteClass:
+			return hb.join(c, isLetter(c) == isLetter(hb.beforeTail())) // WB7, WB11
+		case uax29.Double_QuoteClass:
+			return hb.join(c, c == uax29.Hebrew_LetterClass) // WB7c
+		}
+		return hb.join(c, true) // WB5, WB8 to WB10, WB13b
+	case uax29.ExtendNumLetClass: // WB13a
+		if hb.tail == 0 || hb.last == uax29.ExtendNumLetClass {
+			hb.last = c
+			hb.tail++
+			return true
+		}
+	case uax29.MidLetterClass, uax29.MidNumClass, uax29.MidNumLetClass,
+		uax29.Single_QuoteClass, uax29.Double_QuoteClass: // WB6, WB7b, WB12
+		ok := c == uax29.MidNumLetClass || c == uax29.Single_QuoteClass ||
+			c == uax29.MidLetterClass && isLetter(hb.last) ||
+			c == uax29.MidNumClass && hb.last == uax29.NumericClass ||
+			c == uax29.Double_QuoteClass && hb.last == uax29.Hebrew_LetterClass
+		if hb.tail == 0 && ok {
+			hb.last = c
+			hb.tail = 1
+			return true
+		}
+	}
+	return false
+}
+
+// join makes a letter or digit of class c part of the word, if ok.
+func (hb *Breaker) join(c uax29.UAX29Class, ok bool) bool {
+	if ok {
+		hb.last = c
+		hb.tail = 0
+		hb.skip = hb.skip || c == uax29.NumericClass
+	}
+	return ok
+}
+
+// beforeTail returns the class of the last letter or digit before the
+// punctuation at the end of the word.
+func (hb *Breaker) beforeTail() uax29.UAX29Class {
+	for i := len(hb.word) - hb.tail - 1; i >= 0; i-- {
+		if c := uax29.ClassForRune(hb.word[i]); isLetter(c) || c == uax29.NumericClass {
+			return c
+		}
+	}
+	return uax29.Other
+}
+
+// hyphenate puts penalties at the hyphenation points of the current word. We are
+// called for the first rune after the word, with the tail of the word, i.e.
+// trailing punctuation, not being part of it.
+func (hb *Breaker) hyphenate() {
+	word := hb.word[:len(hb.word)-hb.tail]
+	if hb.dict != nil && !hb.skip {
+		l := len(hb.word)
+		for _, p := range hb.dict.points(word) {
+			if isPunctuation(uax29.ClassForRune(word[p-1])) || isPunctuation(uax29.ClassForRune(word[p])) {
+				continue
+			}
+			// penalties[0] is for the position after the current rune,
+			// penalties[l-p+1] for the position before word[p]
+			for len(hb.penalties) <= l-p+1 {
+				hb.penalties = append(hb.penalties, 0)
+			}
+			hb.penalties[l-p+1] = hb.penalty
+		}
+	}
+	hb.word = hb.word[:0]
+	hb.tail = 0
+	hb.skip = false
+}
+
+func isLetter(c uax29.UAX29Class) bool {
+	return c == uax29.ALetterClass || c == uax29.Hebrew_LetterClass
+}
+
+func isPunctuation(c uax29.UAX29Class) bool {
+	switch c {
+	case uax29.MidLetterClass, uax29.MidNumClass, uax29.MidNumLetClass,
+		uax29.Single_QuoteClass, uax29.Double_QuoteClass, uax29.ExtendNumLetClass:
+		return true
+	}
+	return false
+}
+
+// LongestActiveMatch returns the length of the word currently being read.
+// (Interface uax.UnicodeBreaker)
+func (hb *Breaker) LongestActiveMatch() int {
+	return len(hb.word)
+}
+
+// Penalties is part of interface uax.UnicodeBreaker.
+func (hb *Breaker) Penalties() []int {
+	return hb.penalties
+}
@@ -0,0 +1,329 @@
+/*
+Package hyphenation finds hyphenation points within words, using Liang's algorithm.
+
+Content
+
+UAX#14 does not find line break opportunities within words. For justified text
+and narrow columns, this results in lines with large gaps between words. Package
+hyphenation implements the hyphenation algorithm of TeX (Frank M. Liang:
+Word Hy-phen-a-tion by Com-put-er, 1983), which uses language specific
+patterns to find the positions where a word may be hyphenated.
+
+Patterns and exceptions are read from files in TeX format, as found for many
+languages in the hyph-utf8 project (https://github.com/hyphenation/tex-hyphen):
+
+  % hyph-xx.tex
+  \patterns{
+  .ach4 .ad4der .af1t .al3t
+  ...
+  }
+  \hyphenation{
+  as-so-ciate as-so-ciates
+  ...
+  }
+
+Files are expected to be encoded in UTF-8; the ^^-notation of older pattern files
+is not supported.
+
+Typical Usage
+
+Clients load a dictionary and hyphenate words:
+
+  dict, err := hyphenation.LoadDictionaryFile("hyph-en-us.tex")
+  ...
+  syllables := dict.Hyphenate("hyphenation") // hy-phen-ation
+
+More often, hyphenation points will be used as additional line break
+opportunities. A hyphenation Breaker is intended to be used as a secondary
+breaker next to a UAX#14 line breaker:
+
+  segmenter := segment.NewSegmenter(uax14.NewLineWrap(), hyphenation.NewBreaker(dict))
+
+______________________________________________________________________
+
+License
+
+This project is provided under the terms of the UNLICENSE or
+the 3-Clause BSD license denoted by the following SPDX identifier:
+
+SPDX-License-Identifier: 'Unlicense' OR 'BSD-3-Clause'
+
+You may use the project under the terms of either license.
+
+Licenses are reproduced in the license file in the root folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+*/
+package hyphenation
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/npillmayer/schuko/tracing"
+)
+
+// tracer traces to uax.segment .
+func tracer() tracing.Trace {
+	return tracing.Select("uax.segment")
+}
+
+// === Dictionary ================================================
+
+// Dictionary holds the hyphenation patterns and exceptions for a language.
+//
+// LeftMin and RightMin are the minimum number of characters before the first and
+// after the last hyphenation point of a word (TeX: \lefthyphenmin and
+// \righthyphenmin). They default to 2 and 3, the values for English.
+type Dictionary struct {
+	LeftMin    int
+	RightMin   int
+	patterns   map[string][]uint8 // letters of a pattern → values between letters
+	exceptions map[string][]int   // lower-case word → hyphenation points
+	maxlen     int                // maximum length of a pattern in runes
+}
+
+// NewDictionary creates an empty dictionary.
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		LeftMin:    2,
+		RightMin:   3,
+		patterns:   make(map[string][]uint8),
+		exceptions: make(map[string][]int),
+	}
+}
+
+// AddPatterns adds Liang patterns to a dictionary. A pattern consists of letters
+// and digits between them, e.g. "hen5at"; a full stop marks the start or end of
+// a word.
+func (d *Dictionary) AddPatterns(patterns ...string) error {
+	for _, p := range patterns {
+		var letters []rune
+		values := []uint8{0}
+		for _, r := range p {
+			if r >= '0' && r <= '9' {
+				if values[len(values)-1] != 0 {
+					return fmt.Errorf("malformed hyphenation pattern: %q", p)
+				}
+				values[len(values)-1] = uint8(r - '0')
+				continue
+			}
+			letters = append(letters, unicode.ToLower(r))
+			values = append(values, 0)
+		}
+		if len(letters) == 0 {
+			return fmt.Errorf("malformed hyphenation pattern: %q", p)
+		}
+		d.patterns[string(letters)] = values
+		if len(letters) > d.maxlen {
+			d.maxlen = len(letters)
+		}
+	}
+	return nil
+}
+
+// AddExceptions adds words with explicit hyphenation points to a dictionary,
+// e.g. "as-so-ciate". Exceptions take precedence over patterns. A word
+// without hyphens will not be hyphenated at all.
+func (d *Dictionary) AddExceptions(words ...string) error {
+	for _, w := range words {
+		var letters []rune
+		var points []int
+		for _, r := range w {
+			if r == '-' {
+				if len(letters) == 0 || len(points) > 0 && points[len(points)-1] == len(letters) {
+					return fmt.Errorf("malformed hyphenation exception: %q", w)
+				}
+				points = append(points, len(letters))
+				continue
+			}
+			if r >= '0' && r <= '9' {
+				return fmt.Errorf("malformed hyphenation exception: %q", w)
+			}
+			letters = append(letters, unicode.ToLower(r))
+		}
+		if len(letters) == 0 || len(points) > 0 && points[len(points)-1] == len(letters) {
+			return fmt.Errorf("malformed hyphenation exception: %q", w)
+		}
+		d.exceptions[string(letters)] = points
+	}
+	return nil
+}
+
+// Len returns the number of patterns and the number of exceptions in a dictionary.
+func (d *Dictionary) Len() (int, int) {
+	return len(d.patterns), len(d.exceptions)
+}
+
+// ReadTeX reads patterns and exceptions in TeX format, i.e. the arguments
+// of \patterns{…} and \hyphenation{…} commands. Comments (starting with '%')
+// are skipped, as are other TeX commands and their arguments.
+func (d *Dictionary) ReadTeX(r io.Reader) error {
+	const (
+		skip = iota
+		patterns
+		exceptions
+	)
+	mode, next := skip, skip
+	depth := 0 // nesting of braces within groups to skip
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '%'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.NewReplacer("{", " { ", "}", " } ").Replace(line)
+		for _, token := range strings.Fields(line) {
+			var err error
+			switch {
+			case token == "{":
+				if mode == skip && next != skip && depth == 0 {
+					mode = next
+				} else {
+					depth++
+				}
+				next = skip
+			case token == "}":
+				if depth > 0 {
+					depth--
+				} else {
+					mode = skip
+				}
+			case token == `\patterns`:
+				next = patterns
+			case token == `\hyphenation`:
+				next = exceptions
+			case strings.HasPrefix(token, `\`):
+				next = skip
+			case mode == patterns && depth == 0:
+				err = d.AddPatterns(token)
+			case mode == exceptions && depth == 0:
+				err = d.AddExceptions(token)
+			}
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lineno, err)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// LoadDictionary creates a dictionary from patterns and exceptions in TeX format.
+// See ReadTeX.
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	d := NewDictionary()
+	if err := d.ReadTeX(r); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// LoadDictionaryFile creates a dictionary from one or more files in TeX format,
+// e.g. a pattern file and a file with additional exceptions.
+// See ReadTeX.
+func LoadDictionaryFile(filenames ...string) (*Dictionary, error) {
+	d := NewDictionary()
+	for _, filename := range filenames {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		err = d.ReadTeX(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+	return d, nil
+}
+
+// === Hyphenation ===============================================
+
+// Hyphenate splits a word at its hyphenation points.
+//
+//   dict.Hyphenate("hyphenation") // → ["hy", "phen", "ation"]
+//
+// If no hyphenation point is found, the word is returned as the only syllable.
+func (d *Dictionary) Hyphenate(word string) []string {
+	runes := []rune(word)
+	var syllables []string
+	start := 0
+	for _, p := range d.points(runes) {
+		syllables = append(syllables, string(runes[start:p]))
+		start = p
+	}
+	return append(syllables, string(runes[start:]))
+}
+
+// HyphenationPoints returns the byte offsets of the hyphenation points of a word.
+func (d *Dictionary) HyphenationPoints(word string) []int {
+	points := d.points([]rune(word))
+	if len(points) == 0 {
+		return nil
+	}
+	offsets := make([]int, 0, len(points))
+	i, pos := 0, 0
+	for _, r := range word {
+		if i == points[len(offsets)] {
+			offsets = append(offsets, pos)
+			if len(offsets) == len(points) {
+				break
+			}
+		}
+		i++
+		pos += utf8.RuneLen(r)
+	}
+	return offsets
+}
+
+// points returns the hyphenation points of a word as rune positions, i.e. a
+// point p means a hyphen may be inserted between word[p-1] and word[p].
+func (d *Dictionary) points(word []rune) []int {
+	leftmin, rightmin := max(d.LeftMin, 1), max(d.RightMin, 1)
+	if len(word) < leftmin+rightmin {
+		return nil
+	}
+	w := make([]rune, len(word)+2)
+	w[0], w[len(w)-1] = '.', '.'
+	for i, r := range word {
+		w[i+1] = unicode.ToLower(r)
+	}
+	var points []int
+	if exc, ok := d.exceptions[string(w[1:len(w)-1])]; ok {
+		for _, p := range exc {
+			if p >= leftmin && p <= len(word)-rightmin {
+				points = append(points, p)
+			}
+		}
+		return points
+	}
+	// values[i] is the value for the position before w[i]
+	values := make([]uint8, len(w)+1)
+	for i := range w {
+		for l := 1; l <= d.maxlen && i+l <= len(w); l++ {
+			pattern, ok := d.patterns[string(w[i:i+l])]
+			if !ok {
+				continue
+			}
+			for j, v := range pattern {
+				if v > values[i+j] {
+					values[i+j] = v
+				}
+			}
+		}
+	}
+	for p := leftmin; p <= len(word)-rightmin; p++ {
+		if values[p+1]%2 == 1 { // position before word[p] is before w[p+1]
+			points = append(points, p)
+		}
+	}
+	tracer().Debugf("hyphenation: %q has hyphenation points %v", string(word), points)
+	return points
+}
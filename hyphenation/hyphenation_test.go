@@ -0,0 +1,184 @@
+package hyphenation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/hyphenation"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax14"
+)
+
+func loadTestDictionary(t *testing.T) *hyphenation.Dictionary {
+	dict, err := hyphenation.LoadDictionaryFile("testdata/hyph-test.tex")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dict
+}
+
+func ExampleDictionary_Hyphenate() {
+	dict, _ := hyphenation.LoadDictionaryFile("testdata/hyph-test.tex")
+	fmt.Println(strings.Join(dict.Hyphenate("hyphenation"), "-"))
+	fmt.Println(strings.Join(dict.Hyphenate("associate"), "-"))
+	// Output: hy-phen-ation
+	// as-so-ciate
+}
+
+func ExampleBreaker() {
+	dict, _ := hyphenation.LoadDictionaryFile("testdata/hyph-test.tex")
+	segmenter := segment.NewSegmenter(uax14.NewLineWrap(), hyphenation.NewBreaker(dict))
+	segmenter.InitFromString("Hyphenation by computer.")
+	for segmenter.Next() {
+		p0, p1 := segmenter.Penalties()
+		fmt.Printf("%q %d|%d\n", segmenter.Text(), p0, p1)
+	}
+	// Output: "Hy" 10002|50
+	// "phen" 10002|50
+	// "ation " -29|0
+	// "by " -29|0
+	// "com" 10002|50
+	// "pu" 10002|50
+	// "ter." -19000|0
+}
+
+func TestLoadDictionary(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	dict := loadTestDictionary(t)
+	if p, e := dict.Len(); p != 13 || e != 3 {
+		t.Errorf("expected 13 patterns and 3 exceptions, have %d and %d", p, e)
+	}
+	_, err := hyphenation.LoadDictionary(strings.NewReader(`\patterns{ a1b c12d }`))
+	if err == nil {
+		t.Errorf("expected error for malformed pattern")
+	}
+	_, err = hyphenation.LoadDictionary(strings.NewReader(`\hyphenation{ ab--cd }`))
+	if err == nil {
+		t.Errorf("expected error for malformed exception")
+	}
+	_, err = hyphenation.LoadDictionaryFile("testdata/no-such-file.tex")
+	if err == nil {
+		t.Errorf("expected error for missing file")
+	}
+}
+
+func TestHyphenate(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	dict := loadTestDictionary(t)
+	for i, test := range []struct {
+		word, syllables string
+	}{
+		{"hyphenation", "hy-phen-ation"},
+		{"Hyphenation", "Hy-phen-ation"},
+		{"computer", "com-pu-ter"},
+		{"navigation", "navi-ga-tion"},
+		{"associate", "as-so-ciate"},
+		{"Associate", "As-so-ciate"},
+		{"table", "ta-ble"},
+		{"project", "project"},
+		{"hyphen", "hy-phen"},
+		{"gate", "gate"}, // too short for point after "ga"
+		{"", ""},
+	} {
+		if s := strings.Join(dict.Hyphenate(test.word), "-"); s != test.syllables {
+			t.Errorf("test #%d: expected %q, have %q", i, test.syllables, s)
+		}
+	}
+	dict.LeftMin, dict.RightMin = 1, 1
+	if s := strings.Join(dict.Hyphenate("gate"), "-"); s != "ga-te" {
+		t.Errorf("expected 'ga-te' for hyphen-min of 1, have %q", s)
+	}
+}
+
+func TestHyphenationPoints(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	dict := hyphenation.NewDictionary()
+	if err := dict.AddPatterns("ä1b", "1ße"); err != nil {
+		t.Fatal(err)
+	}
+	points := dict.HyphenationPoints("kläbeßer")
+	if fmt.Sprint(points) != "[4 6]" {
+		t.Errorf("expected hyphenation points [4 6], have %v", points)
+	}
+}
+
+func TestBreakerManualHyphens(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	dict := loadTestDictionary(t)
+	segmenter := segment.NewSegmenter(uax14.NewLineWrap(), hyphenation.NewBreaker(dict))
+	segmenter.InitFromString("hyphen­ation navigation")
+	var fragments []string
+	for segmenter.Next() {
+		fragments = append(fragments, segmenter.Text())
+	}
+	expected := []string{"hyphen­", "ation ", "navi", "ga", "tion"}
+	if strings.Join(fragments, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected fragments %q, have %q", expected, fragments)
+	}
+}
+
+func TestBreakerPenalty(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	dict := loadTestDictionary(t)
+	for _, penalty := range []int{hyphenation.PenaltyForHyphenation, 200} {
+		hb := hyphenation.NewBreaker(dict)
+		if penalty != hyphenation.PenaltyForHyphenation {
+			hb = hyphenation.NewBreaker(dict, penalty)
+		}
+		segmenter := segment.NewSegmenter(uax14.NewLineWrap(), hb)
+		segmenter.InitFromString("Hyphenation")
+		if !segmenter.Next() || segmenter.Text() != "Hy" {
+			t.Fatalf("expected first fragment to be \"Hy\", is %q", segmenter.Text())
+		}
+		if _, p1 := segmenter.Penalties(); p1 != penalty {
+			t.Errorf("expected penalty %d for hyphenation point, have %d", penalty, p1)
+		}
+	}
+}
+
+func TestBreakerWords(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	dict := loadTestDictionary(t)
+	if err := dict.AddExceptions("naviga-tion's"); err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range []struct {
+		text, fragments string
+	}{
+		{"navigation's", "naviga|tion's"},              // exception for the whole word
+		{"navigation’s", "navi|ga|tion’s"},             // right single quotation mark, no exception
+		{"navigation.", "navi|ga|tion."},               // trailing full stop is not part of the word
+		{"gate's navigation", "ga|te's |navi|ga|tion"}, // "gate" alone would be too short
+		{"gate_navigation", "ga|te_navi|ga|tion"},      // no hyphenation point next to "_"
+		{"navigation2", "navigation2"},                 // words with digits are not hyphenated
+	} {
+		segmenter := segment.NewSegmenter(uax14.NewLineWrap(), hyphenation.NewBreaker(dict))
+		segmenter.InitFromString(test.text)
+		var fragments []string
+		for segmenter.Next() {
+			fragments = append(fragments, segmenter.Text())
+		}
+		if s := strings.Join(fragments, "|"); s != test.fragments {
+			t.Errorf("test #%d: expected %q, have %q", i, test.fragments, s)
+		}
+	}
+}
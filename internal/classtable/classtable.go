@@ -0,0 +1,133 @@
+/*
+Package classtable implements two-stage lookup tables for code-point classes.
+
+Breakers have to determine the class of every code-point they read. Checking a
+code-point against a list of unicode.RangeTables is a sequence of binary searches,
+one for each class. A two-stage table finds the class of a code-point with two
+array accesses instead.
+
+The code-point space is split into blocks of BlockSize code-points. Stage 1 maps
+the block number of a code-point to the start of a block of classes in stage 2.
+Blocks with identical content (as most blocks are) are stored only once.
+
+Tables are built by the table generators from the same data as the range
+tables, and written out as Go source code.
+
+License
+
+This project is provided under the terms of the UNLICENSE or
+the 3-Clause BSD license denoted by the following SPDX identifier:
+
+SPDX-License-Identifier: 'Unlicense' OR 'BSD-3-Clause'
+
+You may use the project under the terms of either license.
+
+Licenses are reproduced in the license file in the root folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+*/
+package classtable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"unicode"
+)
+
+const blockShift = 7
+
+// BlockSize is the number of code-points in a block of stage 2.
+const BlockSize = 1 << blockShift
+
+// MaxClasses is the maximum number of classes a table may hold.
+const MaxClasses = 255
+
+// Table is a two-stage lookup table, mapping code-points to code-point classes.
+// Classes are small non-negative integers, as generated for the classes of the
+// various Unicode properties.
+type Table struct {
+	Index  []uint16 // stage 1: offset of block for code-point block r/BlockSize (in units of BlockSize)
+	Blocks []uint8  // stage 2: class+1 for every code-point, 0 if not in any class
+}
+
+// Lookup returns the class for a code-point, or -1 if r is not contained
+// in any of the classes.
+func (t *Table) Lookup(r rune) int {
+	b := int(r >> blockShift)
+	if r < 0 || b >= len(t.Index) {
+		return -1
+	}
+	return int(t.Blocks[int(t.Index[b])<<blockShift|int(r&(BlockSize-1))]) - 1
+}
+
+// Build creates a lookup table from a list of range tables. The class of a
+// code-point is the index of the first range table containing it, i.e., if
+// range tables overlap, the class with the lower index wins. nil entries are
+// allowed and will never match.
+func Build(classes []*unicode.RangeTable) (*Table, error) {
+	if len(classes) > MaxClasses {
+		return nil, errors.New("classtable: too many classes for a lookup table")
+	}
+	t := &Table{}
+	blocks := make(map[string]uint16)
+	block := make([]uint8, BlockSize)
+	lastNonEmpty := -1
+	for b := 0; b <= unicode.MaxRune>>blockShift; b++ {
+		empty := true
+		for i := range block {
+			block[i] = 0
+			r := rune(b<<blockShift | i)
+			for c, rt := range classes {
+				if rt != nil && unicode.Is(rt, r) {
+					block[i] = uint8(c + 1)
+					empty = false
+					break
+				}
+			}
+		}
+		key := string(block)
+		inx, ok := blocks[key]
+		if !ok {
+			inx = uint16(len(t.Blocks) >> blockShift)
+			blocks[key] = inx
+			t.Blocks = append(t.Blocks, block...)
+		}
+		t.Index = append(t.Index, inx)
+		if !empty {
+			lastNonEmpty = b
+		}
+	}
+	t.Index = t.Index[:lastNonEmpty+1] // Lookup returns -1 for code-points beyond
+	return t, nil
+}
+
+// WriteGo writes a table as a Go variable declaration of name varname.
+// The output refers to this package by its name "classtable".
+func (t *Table) WriteGo(w io.Writer, varname string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "\n// %s is a two-stage lookup table for code-point classes.\n", varname)
+	fmt.Fprintf(bw, "var %s = &classtable.Table{\n", varname)
+	bw.WriteString("\tIndex: []uint16{")
+	for i, x := range t.Index {
+		if i%16 == 0 {
+			bw.WriteString("\n\t\t")
+		} else {
+			bw.WriteString(" ")
+		}
+		fmt.Fprintf(bw, "%d,", x)
+	}
+	bw.WriteString("\n\t},\n")
+	bw.WriteString("\tBlocks: []uint8{")
+	for i, x := range t.Blocks {
+		if i%32 == 0 {
+			bw.WriteString("\n\t\t")
+		} else {
+			bw.WriteString(" ")
+		}
+		fmt.Fprintf(bw, "%d,", x)
+	}
+	bw.WriteString("\n\t},\n}\n")
+	return bw.Flush()
+}
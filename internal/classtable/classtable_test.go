@@ -0,0 +1,55 @@
+package classtable
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"testing"
+	"unicode"
+)
+
+func TestLookup(t *testing.T) {
+	classes := []*unicode.RangeTable{unicode.Upper, unicode.Letter, nil, unicode.Digit, unicode.Han}
+	table, err := Build(classes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for r := rune(0); r <= unicode.MaxRune+1; r++ {
+		expected := -1
+		for c, rt := range classes {
+			if rt != nil && unicode.Is(rt, r) {
+				expected = c
+				break
+			}
+		}
+		if c := table.Lookup(r); c != expected {
+			t.Fatalf("expected class %d for %#U, have %d", expected, r, c)
+		}
+	}
+	if c := table.Lookup(-1); c != -1 {
+		t.Errorf("expected class -1 for negative code-point, have %d", c)
+	}
+	t.Logf("table size: %d index entries, %d blocks", len(table.Index), len(table.Blocks)/BlockSize)
+}
+
+func TestWriteGo(t *testing.T) {
+	table, err := Build([]*unicode.RangeTable{unicode.Digit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("package x\n")
+	if err = table.WriteGo(&buf, "digitTable"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = parser.ParseFile(token.NewFileSet(), "x.go", buf.Bytes(), 0); err != nil {
+		t.Errorf("generated table is not valid Go: %v", err)
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	table, _ := Build([]*unicode.RangeTable{unicode.Upper, unicode.Letter, unicode.Digit})
+	for i := 0; i < b.N; i++ {
+		table.Lookup(rune(i & 0xffff))
+	}
+}
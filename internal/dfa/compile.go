@@ -0,0 +1,240 @@
+package dfa
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/npillmayer/uax"
+)
+
+// Compile creates a table from a rule-based breaker. newBreaker has to
+// create a fresh breaker on every call, and symbolFor maps code-points to input
+// symbols, with uax.EOT being the end of text. All the code-points of an
+// input symbol have to be treated alike by the breaker, as the compiler will
+// feed the breaker a single representative code-point for each symbol.
+// Symbols without any code-point (e.g., for a property the code-point tables
+// do not yet provide) cannot occur in a text; the automaton treats them like
+// the end of text.
+//
+// The compiler treats the breaker as a black box. It explores the texts over
+// the representatives, shortest first, and merges texts into a state if the
+// breaker reports the same penalties for every continuation of up to depth
+// symbols. Rules looking further ahead than depth symbols (not counting
+// code-points they ignore) will therefore be compiled incorrectly; a depth of
+// 2 suffices for the rules of UAX#29.
+func Compile(newBreaker func() uax.UnicodeBreaker, symbolFor func(rune) int, depth int) (*Table, error) {
+	reps, eot, err := alphabet(symbolFor)
+	if err != nil {
+		return nil, err
+	}
+	c := &compiler{newBreaker: newBreaker, reps: reps, eot: eot, values: make(map[int]uint8)}
+	c.table = &Table{Symbols: len(reps), EOT: eot}
+	c.conts = [][]int{{}}
+	for l, start := 1, 0; l <= depth; l++ {
+		end := len(c.conts)
+		for _, cont := range c.conts[start:end] {
+			for sym := range reps {
+				if sym != eot && reps[sym] >= 0 {
+					c.conts = append(c.conts, append(cont[:len(cont):len(cont)], sym))
+				}
+			}
+		}
+		start = end
+	}
+	if err = c.explore(); err != nil {
+		return nil, err
+	}
+	return c.table, nil
+}
+
+// alphabet finds a representative code-point for every input symbol, or -1
+// for symbols without any code-point.
+func alphabet(symbolFor func(rune) int) ([]rune, int, error) {
+	eot := symbolFor(uax.EOT)
+	var reps []rune
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		sym := symbolFor(r)
+		if sym < 0 || sym == eot {
+			return nil, 0, fmt.Errorf("dfa: invalid input symbol %d for %#U", sym, r)
+		}
+		for len(reps) <= sym {
+			reps = append(reps, -1)
+		}
+		if reps[sym] < 0 {
+			reps[sym] = r
+		}
+	}
+	for len(reps) <= eot {
+		reps = append(reps, -1)
+	}
+	reps[eot] = uax.EOT
+	return reps, eot, nil
+}
+
+type compiler struct {
+	newBreaker func() uax.UnicodeBreaker
+	reps       []rune        // representative code-point for every input symbol, or -1
+	eot        int           // input symbol for end of text
+	conts      [][]int       // continuations to tell states apart, the first one empty
+	values     map[int]uint8 // penalties to decisions
+	table      *Table
+}
+
+// state is a state of the automaton, represented by the shortest text
+// leading to it.
+type state struct {
+	text    []int   // input symbols
+	pending int     // pending position, or -1
+	sums    [][]int // penalties by position, for text + every continuation
+}
+
+func (c *compiler) explore() error {
+	start, err := c.analyze(nil)
+	if err != nil {
+		return err
+	}
+	states := []*state{start}
+	index := map[string]int{c.signature(start): 0}
+	for q := 0; q < len(states); q++ {
+		s, n := states[q], len(states[q].text)
+		for sym := 0; sym < c.table.Symbols; sym++ {
+			if sym == c.eot || c.reps[sym] < 0 { // the text ends: every position is decided
+				c.table.Next = append(c.table.Next, 0)
+				c.table.Before = append(c.table.Before, c.decision(s.sums[0][n]))
+				c.table.Resolve = append(c.table.Resolve, c.resolve(s, s.sums[0]))
+				continue
+			}
+			next, err := c.analyze(append(s.text[:n:n], sym))
+			if err != nil {
+				return err
+			}
+			if next.pending >= 0 && next.pending != n && next.pending != s.pending {
+				return fmt.Errorf("dfa: position %d of %v pending unexpectedly", next.pending, next.text)
+			}
+			var before, resolve uint8
+			if next.pending != n {
+				before = c.decision(next.sums[0][n])
+			}
+			if next.pending != s.pending {
+				resolve = c.resolve(s, next.sums[0])
+			}
+			sig := c.signature(next)
+			t, ok := index[sig]
+			if !ok {
+				t = len(states)
+				if t > 0xffff {
+					return errors.New("dfa: too many states")
+				}
+				states = append(states, next)
+				index[sig] = t
+			}
+			c.table.Next = append(c.table.Next, uint16(t))
+			c.table.Before = append(c.table.Before, before)
+			c.table.Resolve = append(c.table.Resolve, resolve)
+		}
+	}
+	if len(c.table.Values) > 0xff {
+		return errors.New("dfa: too many distinct penalties")
+	}
+	return nil
+}
+
+// analyze runs the breaker over a text and all its continuations, and finds
+// the position still pending, if any.
+func (c *compiler) analyze(text []int) (*state, error) {
+	s := &state{text: text, pending: -1, sums: make([][]int, len(c.conts))}
+	buf := make([]int, 0, len(text)+len(c.conts[len(c.conts)-1]))
+	for i, cont := range c.conts {
+		s.sums[i] = c.run(append(append(buf[:0], text...), cont...))
+	}
+	for p := 1; p < len(text); p++ {
+		for _, sums := range s.sums {
+			if sums[p] != s.sums[0][p] {
+				if s.pending >= 0 {
+					return nil, fmt.Errorf("dfa: more than one position of %v pending", text)
+				}
+				s.pending = p
+				break
+			}
+		}
+	}
+	return s, nil
+}
+
+// run feeds a text to a fresh breaker, followed by the end of text. It
+// returns the penalties for every position 0…len(text), where position p is
+// the position before the p-th code-point.
+//
+// Penalties are collected the way a segmenter with default settings does:
+// as soon as a position with a penalty suitable for a break has left the
+// longest active match, the text up to it is cut off, and further penalties
+// for the positions cut off are dropped.
+func (c *compiler) run(text []int) []int {
+	breaker := c.newBreaker()
+	sums := make([]int, len(text)+1)
+	front := 1 // first position not yet cut off
+	for i := 0; i <= len(text); i++ {
+		r := uax.EOT
+		if i < len(text) {
+			r = c.reps[text[i]]
+		}
+		cpClass := breaker.CodePointClassFor(r)
+		breaker.StartRulesFor(r, cpClass)
+		breaker.ProceedWithRune(r, cpClass)
+		for k, p := range breaker.Penalties() {
+			if pos := i + 1 - k; pos >= front && pos <= len(text) {
+				sums[pos] += p
+			}
+		}
+		last := min(i+1-breaker.LongestActiveMatch(), len(text))
+		for pos := front; pos <= last; pos++ {
+			if p := sums[pos]; p != 0 && p < uax.InfinitePenalty {
+				front = pos + 1
+			}
+		}
+	}
+	return sums
+}
+
+// signature describes the future behaviour of a state: the penalties for
+// the pending position and all positions from the end of its text onwards,
+// for every continuation.
+func (c *compiler) signature(s *state) string {
+	var b strings.Builder
+	b.WriteString(strconv.FormatBool(s.pending >= 0))
+	n := len(s.text)
+	for _, sums := range s.sums {
+		b.WriteByte('|')
+		if s.pending >= 0 {
+			b.WriteString(strconv.Itoa(sums[s.pending]))
+			b.WriteByte(';')
+		}
+		for _, p := range sums[n:] {
+			b.WriteString(strconv.Itoa(p))
+			b.WriteByte(',')
+		}
+	}
+	return b.String()
+}
+
+// resolve returns the decision for the pending position of a state, or 0.
+func (c *compiler) resolve(s *state, sums []int) uint8 {
+	if s.pending < 0 {
+		return 0
+	}
+	return c.decision(sums[s.pending])
+}
+
+// decision returns the decision to report penalty p.
+func (c *compiler) decision(p int) uint8 {
+	d, ok := c.values[p]
+	if !ok {
+		c.table.Values = append(c.table.Values, p)
+		d = uint8(len(c.table.Values))
+		c.values[p] = d
+	}
+	return d
+}
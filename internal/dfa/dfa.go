@@ -0,0 +1,162 @@
+/*
+Package dfa implements deterministic automata as a fast replacement for
+rule-based breakers.
+
+Breakers driven by rules (see uax.Recognizer) start a set of recognizers for
+every code-point they read. This is easy to follow and to check against the
+rules of the Unicode standard, but slow. A Table is a deterministic automaton
+compiled from such a breaker: it reads one input symbol per code-point and
+reports the same penalties the rules would have reported, without creating any
+recognizers.
+
+Input symbols are small non-negative integers. They refine the code-point
+classes of a breaker such that all the code-points of a symbol are treated
+alike by the rules. One of the symbols stands for the end of text.
+
+Some rules need to look ahead, e.g. to decide if "a:" is to be broken before
+the colon. Tables therefore may leave the position before the current
+code-point undecided (“pending”) and decide it later on. There may be at
+most one pending position at any time.
+
+Tables are compiled by the generators of the breaker packages and written
+out as Go source code (see Generate). The rule-based breakers are kept as a reference, and
+tests compare the two for identical results.
+
+License
+
+This project is provided under the terms of the UNLICENSE or
+the 3-Clause BSD license denoted by the following SPDX identifier:
+
+SPDX-License-Identifier: 'Unlicense' OR 'BSD-3-Clause'
+
+You may use the project under the terms of either license.
+
+Licenses are reproduced in the license file in the root folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+*/
+package dfa
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Table is a deterministic automaton over input symbols. States and symbols
+// index the transitions: transition t for symbol sym in state s is found at
+// t = s*Symbols + sym. The start state is 0.
+//
+// Decisions for positions are indices into Values, offset by 1. Before[t] is
+// the decision for the position before the current code-point, with 0 meaning
+// that the position is pending. Resolve[t] is the decision for a pending
+// position, with 0 meaning that it is still pending (or that there is none).
+type Table struct {
+	Symbols int      // number of input symbols, including EOT
+	EOT     int      // input symbol for the end of text
+	Next    []uint16 // next state for every transition
+	Before  []uint8  // decision for the position before the current code-point
+	Resolve []uint8  // decision for a pending position
+	Values  []int    // penalties to report for a decision
+}
+
+// States returns the number of states of a table.
+func (t *Table) States() int {
+	return len(t.Next) / t.Symbols
+}
+
+// Machine runs a table over a sequence of input symbols. Machines are
+// reset after reading the end of text and may then be re-used.
+type Machine struct {
+	table     *Table
+	state     int   // current state
+	pos       int   // number of code-points read
+	pending   int   // position of an undecided break, or -1
+	penalties []int // penalties of the last step
+}
+
+// NewMachine creates a machine for a table.
+func NewMachine(table *Table) *Machine {
+	return &Machine{table: table, pending: -1, penalties: make([]int, 2, 8)}
+}
+
+// Step reads an input symbol and returns the penalties to report for it.
+// Like the penalties of a uax.UnicodeBreaker, index 0 belongs to the position
+// after the current code-point. The returned slice is valid up to the next
+// call to Step.
+func (m *Machine) Step(sym int) []int {
+	t := m.state*m.table.Symbols + sym
+	m.penalties = m.penalties[:1]
+	m.penalties[0] = 0
+	if d := m.table.Resolve[t]; d > 0 && m.pending >= 0 {
+		m.set(m.pos+1-m.pending, m.table.Values[d-1])
+		m.pending = -1
+	}
+	if d := m.table.Before[t]; d > 0 {
+		m.set(1, m.table.Values[d-1])
+	} else {
+		m.pending = m.pos
+	}
+	m.state = int(m.table.Next[t])
+	m.pos++
+	if sym == m.table.EOT {
+		m.state, m.pos, m.pending = 0, 0, -1
+	}
+	return m.penalties
+}
+
+// set puts penalty p at index k of the penalties.
+func (m *Machine) set(k int, p int) {
+	for len(m.penalties) <= k {
+		m.penalties = append(m.penalties, 0)
+	}
+	m.penalties[k] = p
+}
+
+// LongestActiveMatch returns the number of code-points back to the first
+// position still undecided. This is at least 1, as the position after the
+// current code-point is always undecided.
+func (m *Machine) LongestActiveMatch() int {
+	if m.pending >= 0 {
+		return m.pos - m.pending + 1
+	}
+	return 1
+}
+
+// WriteGo writes a table as a Go variable declaration of name varname.
+// The output refers to this package by its name "dfa".
+func (t *Table) WriteGo(w io.Writer, varname string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "\n// %s is a deterministic automaton with %d states over %d input symbols.\n",
+		varname, t.States(), t.Symbols)
+	fmt.Fprintf(bw, "var %s = &dfa.Table{\n", varname)
+	fmt.Fprintf(bw, "\tSymbols: %d,\n\tEOT: %d,\n", t.Symbols, t.EOT)
+	bw.WriteString("\tNext: []uint16{")
+	for i, x := range t.Next {
+		writeItem(bw, i, t.Symbols, x)
+	}
+	bw.WriteString("\n\t},\n\tBefore: []uint8{")
+	for i, x := range t.Before {
+		writeItem(bw, i, t.Symbols, x)
+	}
+	bw.WriteString("\n\t},\n\tResolve: []uint8{")
+	for i, x := range t.Resolve {
+		writeItem(bw, i, t.Symbols, x)
+	}
+	bw.WriteString("\n\t},\n\tValues: []int{")
+	for i, x := range t.Values {
+		writeItem(bw, i, 16, x)
+	}
+	bw.WriteString("\n\t},\n}\n")
+	return bw.Flush()
+}
+
+// writeItem writes item i of a slice, starting a new line every n items.
+func writeItem(bw *bufio.Writer, i int, n int, x interface{}) {
+	if i%n == 0 {
+		bw.WriteString("\n\t\t")
+	} else {
+		bw.WriteString(" ")
+	}
+	fmt.Fprintf(bw, "%d,", x)
+}
@@ -0,0 +1,169 @@
+package dfa
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/npillmayer/uax"
+)
+
+// toyBreaker breaks between code-points, but not between letters, and not
+// around a colon between letters (like rule WB6/7 of UAX#29).
+type toyBreaker struct {
+	publisher uax.RunePublisher
+	penalties []int
+	match     int
+}
+
+const (
+	toyLetter = iota
+	toyColon
+	toyOther
+	toyEOT
+)
+
+func toySymbol(r rune) int {
+	switch {
+	case r == uax.EOT:
+		return toyEOT
+	case r >= 'a' && r <= 'z':
+		return toyLetter
+	case r == ':':
+		return toyColon
+	}
+	return toyOther
+}
+
+func newToyBreaker() uax.UnicodeBreaker {
+	return &toyBreaker{publisher: uax.NewRunePublisher()}
+}
+
+func (tb *toyBreaker) CodePointClassFor(r rune) int { return toySymbol(r) }
+
+func (tb *toyBreaker) StartRulesFor(r rune, cpClass int) {
+	if cpClass == toyLetter {
+		tb.publisher.SubscribeMe(uax.NewRecognizer(cpClass, toyLetters))
+		tb.publisher.SubscribeMe(uax.NewRecognizer(cpClass, toyColonLetters))
+	}
+}
+
+func (tb *toyBreaker) ProceedWithRune(r rune, cpClass int) {
+	tb.match, tb.penalties = tb.publisher.PublishRuneEvent(r, cpClass)
+	if len(tb.penalties) < 2 {
+		tb.penalties = append(tb.penalties, make([]int, 2-len(tb.penalties))...)
+	}
+	if tb.penalties[1] == 0 {
+		tb.penalties[1] = 10
+	}
+}
+
+func (tb *toyBreaker) LongestActiveMatch() int { return tb.match }
+func (tb *toyBreaker) Penalties() []int        { return tb.penalties }
+
+func toyLetters(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	return func(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+		if cpClass == toyLetter {
+			return uax.DoAccept(rec, 0, uax.InfinitePenalty)
+		}
+		return uax.DoAbort(rec)
+	}
+}
+
+func toyColonLetters(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	return func(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+		if cpClass != toyColon {
+			return uax.DoAbort(rec)
+		}
+		rec.MatchLen++
+		return func(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+			if cpClass == toyLetter {
+				return uax.DoAccept(rec, 0, uax.InfinitePenalty, uax.InfinitePenalty)
+			}
+			return uax.DoAbort(rec)
+		}
+	}
+}
+
+func TestCompile(t *testing.T) {
+	table, err := Compile(newToyBreaker, toySymbol, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("compiled %d states", table.States())
+	c := &compiler{newBreaker: newToyBreaker, reps: []rune{'a', ':', '.', 0}}
+	m := NewMachine(table)
+	for i, text := range []string{"a:b", "a:", "a::b", "ab:.a", ":a:a", "a:b:c.d", ""} {
+		symbols := make([]int, 0, len(text)+1)
+		for _, r := range text + "\x00" {
+			symbols = append(symbols, toySymbol(r))
+		}
+		expected := c.run(symbols[:len(text)])
+		sums := make([]int, len(text)+1)
+		for pos, sym := range symbols {
+			for k, p := range m.Step(sym) {
+				if at := pos + 1 - k; at > 0 && at <= len(text) {
+					sums[at] += p
+				}
+			}
+		}
+		for p := 1; p <= len(text); p++ {
+			if sums[p] != expected[p] {
+				t.Errorf("test #%d: %q: expected penalties %v, have %v", i, text, expected, sums)
+				break
+			}
+		}
+	}
+}
+
+func TestCompileSymbolWithoutCodePoints(t *testing.T) {
+	noColon := func(r rune) int { // no code-point for toyColon
+		if r == ':' {
+			return toyOther
+		}
+		return toySymbol(r)
+	}
+	table, err := Compile(newToyBreaker, noColon, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for s := 0; s < table.States(); s++ {
+		colon, eot := s*table.Symbols+toyColon, s*table.Symbols+toyEOT
+		if table.Next[colon] != 0 || table.Before[colon] != table.Before[eot] {
+			t.Errorf("state %d: expected symbol without code-points to end the text", s)
+		}
+	}
+}
+
+func TestWriteGo(t *testing.T) {
+	table, err := Compile(newToyBreaker, toySymbol, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("package x\n")
+	if err = table.WriteGo(&buf, "toyDFA"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = parser.ParseFile(token.NewFileSet(), "x.go", buf.Bytes(), 0); err != nil {
+		t.Errorf("generated table is not valid Go: %v", err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "toydfa.go")
+	if _, err := Generate("toy", "toyDFA", file, newToyBreaker, toySymbol, 2); err != nil {
+		t.Fatal(err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("generated file is not valid Go: %v", err)
+	}
+	if f.Name.Name != "toy" || f.Scope.Lookup("toyDFA") == nil {
+		t.Errorf("expected package toy declaring toyDFA")
+	}
+}
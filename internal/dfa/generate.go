@@ -0,0 +1,41 @@
+package dfa
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+
+	"github.com/npillmayer/uax"
+)
+
+// Generate compiles a table from a rule-based breaker (see Compile) and writes
+// it to file as Go source code of package pkg, declaring variable varname.
+// It is meant to be called by the generators of the breaker packages, which
+// live in <pkg>/internal/dfagenerator and are run from the directory of pkg.
+func Generate(pkg, varname, file string, newBreaker func() uax.UnicodeBreaker,
+	symbolFor func(rune) int, depth int) (*Table, error) {
+	//
+	table, err := Compile(newBreaker, symbolFor, depth)
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	buf.WriteString("// This file has been generated -- you probably should NOT EDIT IT !\n")
+	fmt.Fprintf(buf, "// Compiled from the rules of %s by %s/internal/dfagenerator.\n\n",
+		strings.TrimPrefix(fmt.Sprintf("%T", newBreaker()), "*"+pkg+"."), pkg)
+	buf.WriteString("import \"github.com/npillmayer/uax/internal/dfa\"\n")
+	if err = table.WriteGo(buf, varname); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if err = os.WriteFile(file, src, 0644); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
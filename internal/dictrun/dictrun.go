@@ -0,0 +1,80 @@
+/*
+Package dictrun helps breakers to tailor their break opportunities within runs
+of text segmented by a dictionary.
+
+Breakers driven by rules report penalties to the segmenter rune by rune, and the
+segmenter adds them up. When a run of dictionary-segmented text is complete,
+a breaker has already reported penalties between its runes. A Run therefore
+records every penalty reported within the run, and corrects the sums once the
+dictionary has found the word boundaries.
+*/
+package dictrun
+
+import "github.com/npillmayer/uax/dictionary"
+
+// Run collects a run of runes to be segmented by a word list.
+type Run struct {
+	words   *dictionary.WordList
+	runes   []rune
+	emitted []int // emitted[i] is the sum of the penalties reported after runes[i]
+}
+
+// New creates a run for a word list.
+func New(words *dictionary.WordList) *Run {
+	return &Run{words: words}
+}
+
+// Len returns the number of runes in the run.
+func (run *Run) Len() int {
+	if run == nil {
+		return 0
+	}
+	return len(run.runes)
+}
+
+// Append adds the most recently read rune to the run.
+func (run *Run) Append(r rune) {
+	run.runes = append(run.runes, r)
+	run.emitted = append(run.emitted, 0)
+}
+
+// Record notes the penalties a breaker reports for the most recently read rune,
+// with penalties[0] belonging to the position after it. If the run is still
+// open, the most recently read rune is its last rune, otherwise the rune after
+// the run.
+func (run *Run) Record(penalties []int, open bool) {
+	last := len(run.runes) - 1
+	if !open {
+		last++
+	}
+	for i, p := range penalties {
+		if at := last - i; at >= 0 && at < len(run.runes) {
+			run.emitted[at] += p
+		}
+	}
+}
+
+// Close is called for the first rune after the run, with the penalties the
+// breaker is about to report for it. Close segments the run and returns
+// the penalties corrected for the positions within the run: boundaries
+// between words get penalty brk, all other positions get penalty suppress.
+// The run is then reset.
+func (run *Run) Close(penalties []int, brk, suppress int) []int {
+	run.Record(penalties, false)
+	l := len(run.runes)
+	for len(penalties) <= l {
+		penalties = append(penalties, 0)
+	}
+	boundaries := run.words.Segment(run.runes)
+	for i := 0; i < l-1; i++ { // position after runes[i] has index l-i
+		target := suppress
+		if len(boundaries) > 0 && boundaries[0] == i+1 {
+			target = brk
+			boundaries = boundaries[1:]
+		}
+		penalties[l-i] += target - run.emitted[i]
+	}
+	run.runes = run.runes[:0]
+	run.emitted = run.emitted[:0]
+	return penalties
+}
@@ -194,5 +194,8 @@ func (sc *scanner) ScanItemBody(token *Token) (*Token, scannerStep) {
 	a[0] = strings.TrimSpace(a[0])
 	a[0] = strings.TrimLeft(a[0], ";")
 	token.Fields = strings.Split(a[0], ";")
+	for i, f := range token.Fields {
+		token.Fields[i] = strings.TrimSpace(f)
+	}
 	return token, nil
 }
@@ -0,0 +1,182 @@
+package segment
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/npillmayer/uax"
+)
+
+// BreakerFactory creates a fresh UnicodeBreaker.
+//
+// Breakers keep state between runes, therefore they may not be re-used for
+// segmenting from a different position in the text. Clients which have to
+// restart segmenting, e.g. BreakIterators, need a way to create new breakers.
+type BreakerFactory func() uax.UnicodeBreaker
+
+// Done is returned by BreakIterator queries if there is no boundary to be found.
+const Done = -1
+
+// resyncDistance is the maximum distance (in bytes) a BreakIterator will continue
+// its current segmenting run to reach an offset, instead of re-starting.
+const resyncDistance = 1024
+
+// maxCachedBoundaries limits the size of a BreakIterator's boundary cache.
+const maxCachedBoundaries = 4096
+
+// BreakIterator provides random access to the segment boundaries of an in-memory
+// text, similar to ICU's break iterators. Boundaries are byte offsets into the text.
+// The start and the end of the text are always boundaries.
+//
+// A BreakIterator will not rescan the text from the start for every query. Instead
+// it re-starts segmenting from the start of the line containing the queried offset.
+// Every Unicode breaker of this module (grapheme, word, sentence and line) has a
+// mandatory break after a line feed, which makes line starts safe positions to
+// re-synchronise: boundaries found from there on are the same as if segmenting
+// had started at the start of the text. Re-starting at an arbitrary position
+// would not be safe, as breakers may depend on unbounded context, e.g., on the
+// number of regional indicators preceding a position. Random access to texts
+// with very long lines is therefore expensive.
+//
+// Queries proceeding in forward direction will continue the current segmenting
+// run, i.e. iterating with Following is not much more expensive than using a
+// Segmenter.
+type BreakIterator struct {
+	text      string
+	factories []BreakerFactory
+	seg       *Segmenter // segmenter of the current run, nil if exhausted
+	segstart  int        // byte position where seg started
+	bounds    []int      // all boundaries between bounds[0] and bounds[len-1]
+}
+
+// NewBreakIterator creates a BreakIterator from one or more breaker factories.
+// The first factory creates the primary breaker, any further factories create
+// secondary breakers (see NewSegmenter).
+//
+// Before using it, clients will have to initialize it with InitFromString or
+// InitFromBytes.
+func NewBreakIterator(factories ...BreakerFactory) *BreakIterator {
+	return &BreakIterator{factories: factories}
+}
+
+// InitFromString initializes a BreakIterator with a text.
+func (it *BreakIterator) InitFromString(text string) {
+	it.text = text
+	it.seg = nil
+	it.segstart = 0
+	it.bounds = it.bounds[:0]
+}
+
+// InitFromBytes initializes a BreakIterator with a text. The text is copied.
+func (it *BreakIterator) InitFromBytes(text []byte) {
+	it.InitFromString(string(text))
+}
+
+// Following returns the first boundary after offset, or Done if offset is at or
+// beyond the end of the text.
+func (it *BreakIterator) Following(offset int) int {
+	if offset >= len(it.text) {
+		return Done
+	}
+	if offset < 0 {
+		return 0
+	}
+	it.cover(offset)
+	i := sort.SearchInts(it.bounds, offset+1)
+	return it.bounds[i]
+}
+
+// Preceding returns the last boundary before offset, or Done if offset is at or
+// before the start of the text.
+func (it *BreakIterator) Preceding(offset int) int {
+	if offset <= 0 {
+		return Done
+	}
+	if offset > len(it.text) {
+		return len(it.text)
+	}
+	it.cover(offset - 1)
+	i := sort.SearchInts(it.bounds, offset)
+	return it.bounds[i-1]
+}
+
+// IsBoundary checks if offset is a boundary.
+func (it *BreakIterator) IsBoundary(offset int) bool {
+	if offset == 0 || offset == len(it.text) {
+		return true
+	}
+	if offset < 0 || offset > len(it.text) {
+		return false
+	}
+	it.cover(offset)
+	i := sort.SearchInts(it.bounds, offset)
+	return it.bounds[i] == offset
+}
+
+// cover makes sure that the boundary cache covers offset, i.e.
+// bounds[0] ≤ offset < bounds[len-1]. Offset must be a valid position within the text.
+func (it *BreakIterator) cover(offset int) {
+	pos := it.restartPosition(offset)
+	if l := len(it.bounds); l > 0 && it.bounds[0] <= offset {
+		if offset < it.bounds[l-1] {
+			return
+		}
+		if it.seg != nil && (offset-it.bounds[l-1] <= resyncDistance || pos <= it.bounds[l-1]) {
+			it.extend(offset) // cheaper than re-starting
+			return
+		}
+	}
+	it.restart(pos)
+	it.extend(offset)
+}
+
+// restartPosition finds the start of the line containing offset, where
+// segmenting may safely be re-started.
+func (it *BreakIterator) restartPosition(offset int) int {
+	return strings.LastIndexByte(it.text[:offset], '\n') + 1
+}
+
+// restart starts a new segmenting run at position pos, which has to be a
+// safe position (see restartPosition).
+func (it *BreakIterator) restart(pos int) {
+	tracer().Debugf("break iterator: re-starting at position %d", pos)
+	breakers := make([]uax.UnicodeBreaker, len(it.factories))
+	for i, f := range it.factories {
+		breakers[i] = f()
+	}
+	it.seg = NewSegmenter(breakers...)
+	it.seg.Init(strings.NewReader(it.text[pos:]))
+	it.segstart = pos
+	it.bounds = append(it.bounds[:0], pos)
+}
+
+// extend continues the current segmenting run until a boundary after offset
+// has been found.
+func (it *BreakIterator) extend(offset int) {
+	for len(it.bounds) == 0 || it.bounds[len(it.bounds)-1] <= offset {
+		if !it.next() {
+			break
+		}
+	}
+	if len(it.bounds) > maxCachedBoundaries {
+		n := copy(it.bounds, it.bounds[len(it.bounds)-maxCachedBoundaries/2:])
+		it.bounds = it.bounds[:n]
+	}
+}
+
+// next appends the next boundary of the current segmenting run to the cache.
+func (it *BreakIterator) next() bool {
+	if it.seg == nil {
+		return false
+	}
+	if !it.seg.Next() {
+		it.seg = nil
+		if l := len(it.bounds); l == 0 || it.bounds[l-1] < len(it.text) {
+			it.bounds = append(it.bounds, len(it.text)) // end of text is always a boundary
+		}
+		return true
+	}
+	_, end := it.seg.ByteOffsets()
+	it.bounds = append(it.bounds, it.segstart+end)
+	return true
+}
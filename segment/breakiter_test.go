@@ -0,0 +1,130 @@
+package segment_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/grapheme"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+var breakIterText = `Hello World! This is a test for the break iterator.
+It has more than one line, "quotes", numbers like 3.14, and emojis 👍🏽 and 🇩🇪🇫🇷.
+Übermäßig lange Wörter wie Donaudampfschifffahrtsgesellschaftskapitän.
+
+The end.`
+
+// forwardBoundaries gets all boundaries of a text by segmenting from the start.
+func forwardBoundaries(text string, factory segment.BreakerFactory) map[int]bool {
+	seg := segment.NewSegmenter(factory())
+	seg.Init(strings.NewReader(text))
+	bounds := map[int]bool{0: true, len(text): true}
+	pos := 0
+	for seg.Next() {
+		pos += len(seg.Bytes())
+		bounds[pos] = true
+	}
+	return bounds
+}
+
+func checkBreakIterator(t *testing.T, text string, factory segment.BreakerFactory) {
+	expected := forwardBoundaries(text, factory)
+	it := segment.NewBreakIterator(factory)
+	it.InitFromString(text)
+	offsets := rand.Perm(len(text) + 1)
+	for _, offset := range offsets {
+		if it.IsBoundary(offset) != expected[offset] {
+			t.Errorf("IsBoundary(%d) should be %v", offset, expected[offset])
+		}
+		following := segment.Done
+		for i := offset + 1; i <= len(text); i++ {
+			if expected[i] {
+				following = i
+				break
+			}
+		}
+		if f := it.Following(offset); f != following {
+			t.Errorf("Following(%d) should be %d, is %d", offset, following, f)
+		}
+		preceding := segment.Done
+		for i := offset - 1; i >= 0; i-- {
+			if expected[i] {
+				preceding = i
+				break
+			}
+		}
+		if p := it.Preceding(offset); p != preceding {
+			t.Errorf("Preceding(%d) should be %d, is %d", offset, preceding, p)
+		}
+	}
+}
+
+func TestBreakIteratorWords(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	checkBreakIterator(t, breakIterText, func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+}
+
+func TestBreakIteratorGraphemes(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	checkBreakIterator(t, breakIterText, func() uax.UnicodeBreaker {
+		return grapheme.NewBreaker(1)
+	})
+}
+
+func TestBreakIteratorLongText(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	text := strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 60)
+	checkBreakIterator(t, text, func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+}
+
+func TestBreakIteratorRegionalIndicators(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	// flags are pairs of regional indicators, which cannot be told apart
+	// without counting from the start of the run
+	text := strings.Repeat("🇩🇪", 400) + "x"
+	checkBreakIterator(t, text, func() uax.UnicodeBreaker {
+		return grapheme.NewBreaker(1)
+	})
+	checkBreakIterator(t, text, func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+}
+
+func TestBreakIteratorIterate(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	it := segment.NewBreakIterator(func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+	it.InitFromString("Hello World!")
+	var words []string
+	for pos, next := 0, it.Following(0); next != segment.Done; pos, next = next, it.Following(next) {
+		words = append(words, "Hello World!"[pos:next])
+	}
+	if strings.Join(words, "|") != "Hello| |World|!" {
+		t.Errorf("unexpected words: %q", words)
+	}
+}
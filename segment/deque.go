@@ -27,14 +27,29 @@ type deque struct {
 }
 
 // Internal type atom holds a rune and 2 penalties (for a break opportunity).
+// Additionally it holds the penalty of each breaker separately.
 type atom struct {
-	r        rune
-	penalty0 int // primary penalty
-	penalty1 int // penalty for all secondary breakers
+	r         rune
+	penalty0  int   // primary penalty
+	penalty1  int   // penalty for all secondary breakers
+	size      int   // size of the rune in the input, in bytes; 0 for eot
+	wordType  int   // type of the rune, if the primary breaker is a uax.WordTyper
+	penalties []int // penalties per breaker; backing array is re-used
 }
 
 // the atom denoting End of Text
-var eotAtom = atom{rune(0), uax.InfinitePenalty, uax.InfinitePenalty}
+var eotAtom = atom{r: uax.EOT, penalty0: uax.InfinitePenalty, penalty1: uax.InfinitePenalty}
+
+// resetPenalties sets the penalty of each of n breakers to p.
+func (a *atom) resetPenalties(n int, p int) {
+	if cap(a.penalties) < n {
+		a.penalties = make([]int, n)
+	}
+	a.penalties = a.penalties[:n]
+	for i := range a.penalties {
+		a.penalties[i] = p
+	}
+}
 
 func (a *atom) String() string {
 	return fmt.Sprintf("[%+q p=%d|%d]", a.r, a.penalty0, a.penalty1)
@@ -56,7 +71,7 @@ func (q *deque) PushBack(r rune, p0 int, p1 int) {
 	if q.count >= len(q.buf) {
 		q.growIfFull()
 	}
-	q.buf[q.tail] = atom{r: r, penalty0: p0, penalty1: p1}
+	q.buf[q.tail] = atom{r: r, penalty0: p0, penalty1: p1, penalties: q.buf[q.tail].penalties[:0]}
 	// Calculate new tail position.
 	q.tail = q.next(q.tail)
 	q.count++
@@ -84,7 +99,7 @@ func (q *deque) PopFront() (rune, int, int) {
 	r := q.buf[q.head].r
 	p0 := q.buf[q.head].penalty0
 	p1 := q.buf[q.head].penalty1
-	q.buf[q.head] = atom{} // re-initialize atom
+	q.buf[q.head] = atom{penalties: q.buf[q.head].penalties[:0]} // re-initialize atom
 	// Calculate new head position.
 	q.head = q.next(q.head)
 	q.count--
@@ -109,6 +124,7 @@ func (q *deque) PopBack() (rune, int, int) {
 	q.buf[q.tail].r = 0        // re-initialize atom
 	q.buf[q.tail].penalty0 = 0 // re-initialize atom
 	q.buf[q.tail].penalty1 = 0 // re-initialize atom
+	q.buf[q.tail].size = 0     // re-initialize atom
 	q.count--
 
 	q.shrinkIfExcess()
@@ -206,6 +222,8 @@ func (q *deque) Clear() {
 		q.buf[h].r = 0
 		q.buf[h].penalty0 = 0
 		q.buf[h].penalty1 = 0
+		q.buf[h].size = 0
+		q.buf[h].penalties = q.buf[h].penalties[:0]
 	}
 	q.head = 0
 	q.tail = 0
@@ -0,0 +1,81 @@
+package segment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/npillmayer/uax"
+)
+
+// Explain segments a text with a single breaker and renders the result in the
+// notation of the Unicode test files (e.g., WordBreakTest.txt): code-points
+// are written as hex numbers, '÷' marks a boundary and '×' marks a position
+// without a boundary. If the breaker is a uax.RuleObservable, every position is
+// annotated with the rules which contributed a penalty for it:
+//
+//   ÷ 0063 × [WB5] 0061 × [WB5] 006E × [WB6_7] 0027 × [WB6_7] 0074 ÷ [NewLine] 000A ÷ [NewLine]
+//
+// Explain is meant as a debugging aid for breakers and is not tuned for
+// performance. The breaker should be freshly created, as it will not be
+// reset after use.
+func Explain(breaker uax.UnicodeBreaker, text string) string {
+	cnt := &countingBreaker{UnicodeBreaker: breaker}
+	runes := []rune(text)
+	rules := make([][]string, len(runes)+1)
+	if obs, ok := breaker.(uax.RuleObservable); ok {
+		obs.SetRuleObserver(func(e uax.RuleEvent) {
+			if e.Kind != uax.RuleAccepted {
+				return
+			}
+			for k, p := range e.Penalties {
+				if b := cnt.pos + 1 - k; p != 0 && b >= 0 && b < len(rules) {
+					rules[b] = appendRule(rules[b], e.Rule)
+				}
+			}
+		})
+		defer obs.SetRuleObserver(nil)
+	}
+	boundaries := make([]bool, len(runes)+1)
+	seg := NewSegmenter(cnt)
+	seg.InitFromSlice(runes)
+	for seg.Next() {
+		_, end := seg.RuneOffsets()
+		boundaries[end] = true
+	}
+	boundaries[0], boundaries[len(runes)] = true, true
+	var b strings.Builder
+	for i := range boundaries {
+		if i > 0 {
+			fmt.Fprintf(&b, " %04X ", runes[i-1])
+		}
+		if boundaries[i] {
+			b.WriteString("÷")
+		} else {
+			b.WriteString("×")
+		}
+		if len(rules[i]) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(rules[i], " "))
+		}
+	}
+	return b.String()
+}
+
+// countingBreaker tracks the position of the rune a breaker is working on.
+type countingBreaker struct {
+	uax.UnicodeBreaker
+	pos int // position of the current rune
+}
+
+func (cb *countingBreaker) ProceedWithRune(r rune, cpClass int) {
+	cb.UnicodeBreaker.ProceedWithRune(r, cpClass)
+	cb.pos++
+}
+
+func appendRule(rules []string, rule string) []string {
+	for _, r := range rules {
+		if r == rule {
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
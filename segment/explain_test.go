@@ -0,0 +1,28 @@
+package segment_test
+
+import (
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func TestExplain(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	for i, test := range []struct {
+		text     string
+		expected string
+	}{
+		{"can't\n", "÷ 0063 × [WB5] 0061 × [WB5] 006E × [WB6_7] 0027 × [WB6_7] 0074 ÷ [NewLine] 000A ÷ [NewLine]"},
+		{"Hi 3.5!", "÷ 0048 × [WB5] 0069 ÷ 0020 ÷ 0033 × [WB11] 002E × [WB11] 0035 ÷ 0021 ÷"},
+	} {
+		if e := segment.Explain(uax29.NewWordBreaker(1), test.text); e != test.expected {
+			t.Errorf("test #%d: expected\n  %s\nhave\n  %s", i, test.expected, e)
+		}
+	}
+}
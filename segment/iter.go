@@ -0,0 +1,57 @@
+package segment
+
+import (
+	"iter"
+
+	"github.com/npillmayer/uax"
+)
+
+// Segment is a segment of text as delivered by the iterator Segments.
+type Segment struct {
+	Text       string // the text of the segment, a substring of the input
+	Start, End int    // byte offsets of the segment within the input
+	Penalty0   int    // penalty of the primary breaker for breaking after this segment
+	Penalty1   int    // aggregated penalties of secondary breakers (see Segmenter.Penalties)
+}
+
+// All returns an iterator over the segments of a text, as found by a breaker:
+//
+//   for word := range segment.All(uax29.NewWordBreaker(1), "Hello World!") {
+//       fmt.Println(word)
+//   }
+//
+// Breakers keep state between runes, therefore the iterator may be used only
+// once. The segments are substrings of text, no allocation is performed for them.
+func All(breaker uax.UnicodeBreaker, text string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		seg := NewSegmenter(breaker)
+		seg.InitFromString(text)
+		for seg.Next() {
+			if !yield(seg.Text()) {
+				return
+			}
+		}
+	}
+}
+
+// Segments returns an iterator over the segments of a text, including the
+// positions of the segments and the penalties for breaking after them.
+// For more than one breaker, the first one is the primary breaker and any
+// following breaker is a secondary breaker (see NewSegmenter).
+//
+// Breakers keep state between runes, therefore the iterator may be used only
+// once.
+func Segments(text string, breakers ...uax.UnicodeBreaker) iter.Seq[Segment] {
+	return func(yield func(Segment) bool) {
+		seg := NewSegmenter(breakers...)
+		seg.InitFromString(text)
+		for seg.Next() {
+			s := Segment{Text: seg.Text()}
+			s.Start, s.End = seg.ByteOffsets()
+			s.Penalty0, s.Penalty1 = seg.Penalties()
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package segment_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func ExampleAll() {
+	for word := range segment.All(uax29.NewWordBreaker(1), "Hello World!") {
+		fmt.Printf("'%s'\n", word)
+	}
+	// Output: 'Hello'
+	// ' '
+	// 'World'
+	// '!'
+}
+
+func ExampleSegments() {
+	for s := range segment.Segments("Hello World!") { // uses a SimpleWordBreaker
+		fmt.Printf("%2d…%2d '%s' p=%d\n", s.Start, s.End, s.Text, s.Penalty0)
+	}
+	// Output:  0… 5 'Hello' p=100
+	//  5… 6 ' ' p=-100
+	//  6…12 'World!' p=100
+}
+
+func TestIteratorEarlyExit(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	var words []string
+	for word := range segment.All(uax29.NewWordBreaker(1), "Hello World, how are you?") {
+		if word == "how" {
+			break
+		}
+		words = append(words, word)
+	}
+	if strings.Join(words, "|") != "Hello| |World|,| " {
+		t.Errorf("unexpected words before break: %q", words)
+	}
+}
@@ -0,0 +1,70 @@
+package segment
+
+import "github.com/npillmayer/uax"
+
+// BoundaryPolicy tells a segmenter which of its breakers decide about segment
+// boundaries.
+type BoundaryPolicy int
+
+// Boundary policies. With policy DefaultBoundaries, a position is a boundary
+// if either the primary breaker or the aggregate of all secondary breakers signal
+// a break opportunity. Policy SecondaryVeto works the same way, but lets
+// secondary breakers suppress breaks: if their aggregate penalty at a position is
+// uax.InfinitePenalty or greater, the position is not a boundary, regardless of
+// the penalty of the primary breaker.
+const (
+	DefaultBoundaries BoundaryPolicy = iota // see above
+	AnyBreaker                              // any breaker signals a break opportunity
+	AllBreakers                             // every breaker signals a break opportunity
+	PrimaryOnly                             // the primary breaker signals a break opportunity
+	SecondaryVeto                           // see above
+)
+
+// SetBoundaryPolicy sets the policy which decides about segment boundaries,
+// given the penalties of the breakers. The default is DefaultBoundaries.
+// Zero penalties are considered break opportunities according to
+// BreakOnZero(…), where all secondary breakers share the setting for P2.
+func (s *Segmenter) SetBoundaryPolicy(policy BoundaryPolicy) {
+	s.policy = policy
+	s.isBoundary = nil
+}
+
+// SetBoundaryPredicate sets a custom policy which decides about segment boundaries.
+// isBoundary is called with the penalties of all breakers at a position, indexed
+// in the order of the breakers given to NewSegmenter. It should return true if
+// the position is a segment boundary. Setting a nil predicate restores the
+// policy set with SetBoundaryPolicy.
+func (s *Segmenter) SetBoundaryPredicate(isBoundary func(penalties []int) bool) {
+	s.isBoundary = isBoundary
+}
+
+// isPossibleBoundary applies the boundary policy to the penalties of an atom.
+func (s *Segmenter) isPossibleBoundary(q *atom) bool {
+	if s.isBoundary != nil {
+		return s.isBoundary(q.penalties)
+	}
+	switch s.policy {
+	case AnyBreaker:
+		for i, p := range q.penalties {
+			if isPossibleBreak(p, s.breakOnZero[min(i, 1)]) {
+				return true
+			}
+		}
+		return false
+	case AllBreakers:
+		for i, p := range q.penalties {
+			if !isPossibleBreak(p, s.breakOnZero[min(i, 1)]) {
+				return false
+			}
+		}
+		return true
+	case PrimaryOnly:
+		return isPossibleBreak(q.penalty0, s.breakOnZero[0])
+	case SecondaryVeto:
+		if len(s.breakers) > 1 && q.penalty1 >= uax.InfinitePenalty {
+			return false // secondary breakers veto a break at this position
+		}
+	}
+	return isPossibleBreak(q.penalty0, s.breakOnZero[0]) ||
+		(len(s.breakers) > 1 && isPossibleBreak(q.penalty1, s.breakOnZero[1]))
+}
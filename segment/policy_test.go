@@ -0,0 +1,97 @@
+package segment_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax14"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func TestBoundaryPolicies(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	// break where the word breaker breaks, unless the line breaker strongly objects
+	wordsUnlessPunct := func(penalties []int) bool {
+		return penalties[1] < uax.InfinitePenalty && penalties[0] < 2*uax.InfinitePenalty
+	}
+	for i, test := range []struct {
+		policy    segment.BoundaryPolicy
+		predicate func([]int) bool
+		segments  string
+	}{
+		{segment.DefaultBoundaries, nil, "Hello|,| |world"},
+		{segment.AnyBreaker, nil, "Hello|,| |world"},
+		{segment.AllBreakers, nil, "Hello, |world"},
+		{segment.PrimaryOnly, nil, "Hello, |world"},
+		{segment.DefaultBoundaries, wordsUnlessPunct, "Hello|, |world"},
+	} {
+		seg := segment.NewSegmenter(uax14.NewLineWrap(), uax29.NewWordBreaker(1))
+		seg.SetBoundaryPolicy(test.policy)
+		seg.SetBoundaryPredicate(test.predicate)
+		seg.InitFromString("Hello, world")
+		var segments []string
+		for seg.Next() {
+			segments = append(segments, seg.Text())
+		}
+		if s := strings.Join(segments, "|"); s != test.segments {
+			t.Errorf("test #%d: expected %q, have %q", i, test.segments, s)
+		}
+	}
+}
+
+func TestSecondaryVeto(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	// the line breaker allows a break after a soft hyphen, where the word
+	// breaker does not break (WB4)
+	for i, test := range []struct {
+		policy   segment.BoundaryPolicy
+		segments string
+	}{
+		{segment.DefaultBoundaries, "hyphen\u00AD|ation"},
+		{segment.SecondaryVeto, "hyphen\u00ADation"},
+	} {
+		seg := segment.NewSegmenter(uax14.NewLineWrap(), uax29.NewWordBreaker(1))
+		seg.SetBoundaryPolicy(test.policy)
+		seg.InitFromString("hyphen\u00ADation")
+		var segments []string
+		for seg.Next() {
+			segments = append(segments, seg.Text())
+		}
+		if s := strings.Join(segments, "|"); s != test.segments {
+			t.Errorf("test #%d: expected %q, have %q", i, test.segments, s)
+		}
+	}
+}
+
+func TestBreakerPenalties(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	seg := segment.NewSegmenter(uax14.NewLineWrap(), uax29.NewWordBreaker(1), uax29.NewWordBreaker(3))
+	seg.InitFromString("Hello, world")
+	var segments []string
+	for seg.Next() {
+		p0, p1 := seg.Penalties()
+		pv := seg.BreakerPenalties()
+		if len(pv) != 3 || pv[0] != p0 || pv[1]+pv[2] != p1 {
+			t.Errorf("penalties per breaker %v do not match penalties %d|%d", pv, p0, p1)
+		}
+		segments = append(segments, fmt.Sprintf("%s/%v", seg.Text(), pv))
+	}
+	expected := "Hello/[10066 10 30]|,/[20000 10 30]| /[-29 10 30]|world/[-19000 10 30]"
+	if s := strings.Join(segments, "|"); s != expected {
+		t.Errorf("expected %q, have %q", expected, s)
+	}
+}
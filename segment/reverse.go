@@ -0,0 +1,96 @@
+package segment
+
+// ReverseSegmenter steps through the segments of an in-memory text from the
+// end to the start. It is intended for editing operations like moving the
+// cursor to the left or deleting the previous word:
+//
+//   rev := segment.NewReverseSegmenter(func() uax.UnicodeBreaker {
+//       return grapheme.NewBreaker(1)
+//   })
+//   rev.InitFromString("Hello 世界!")
+//   for rev.Previous() {
+//       // do something with rev.Text() or rev.Bytes()
+//   }
+//
+// The UAX breaking algorithms are specified for reading text in forward
+// direction, and the breakers of this module implement them as state machines
+// consuming text from left to right. The ReverseSegmenter therefore does not run
+// the rules backwards, but uses a BreakIterator, which re-starts forward
+// segmenting at the start of the current line (see BreakIterator). This
+// guarantees the same boundaries as forward segmenting, with the exception that
+// the end of the text is always a boundary. Stepping backwards through a very
+// long line is expensive, as the line may have to be segmented more than once.
+//
+// Unlike a Segmenter, a ReverseSegmenter does not report penalties.
+type ReverseSegmenter struct {
+	it         *BreakIterator
+	text       string
+	start, end int // byte offsets of the current segment
+}
+
+// NewReverseSegmenter creates a ReverseSegmenter from one or more breaker factories.
+// The first factory creates the primary breaker, any further factories create
+// secondary breakers (see NewSegmenter).
+//
+// Before using it, clients will have to initialize it with InitFromString or
+// InitFromBytes.
+func NewReverseSegmenter(factories ...BreakerFactory) *ReverseSegmenter {
+	return &ReverseSegmenter{it: NewBreakIterator(factories...)}
+}
+
+// InitFromString initializes a ReverseSegmenter with a text. Segmenting will
+// start at the end of the text.
+func (rs *ReverseSegmenter) InitFromString(text string) {
+	rs.text = text
+	rs.it.InitFromString(text)
+	rs.start, rs.end = len(text), len(text)
+}
+
+// InitFromBytes initializes a ReverseSegmenter with a text. The text is copied.
+// Segmenting will start at the end of the text.
+func (rs *ReverseSegmenter) InitFromBytes(text []byte) {
+	rs.InitFromString(string(text))
+}
+
+// SetPosition sets the byte offset to segment backwards from. The next call to
+// Previous() will return the segment ending at offset, or, if offset is not a
+// boundary, the part of a segment between its start and offset. offset
+// is capped to the length of the text.
+func (rs *ReverseSegmenter) SetPosition(offset int) {
+	if offset < 0 {
+		offset = 0
+	} else if offset > len(rs.text) {
+		offset = len(rs.text)
+	}
+	rs.start, rs.end = offset, offset
+}
+
+// Previous moves the ReverseSegmenter to the segment before the current one.
+// It returns false if the start of the text has been reached.
+func (rs *ReverseSegmenter) Previous() bool {
+	if rs.start <= 0 {
+		rs.end = 0
+		return false
+	}
+	rs.end = rs.start
+	rs.start = rs.it.Preceding(rs.end)
+	return true
+}
+
+// Text returns the most recent segment found by a call to Previous().
+// The string is a substring of the input text and does not allocate.
+func (rs *ReverseSegmenter) Text() string {
+	return rs.text[rs.start:rs.end]
+}
+
+// Bytes returns the most recent segment found by a call to Previous()
+// as a newly allocated byte slice.
+func (rs *ReverseSegmenter) Bytes() []byte {
+	return []byte(rs.Text())
+}
+
+// ByteOffsets returns the start and end position of the most recent segment
+// found by a call to Previous(), in bytes from the start of the input text.
+func (rs *ReverseSegmenter) ByteOffsets() (int, int) {
+	return rs.start, rs.end
+}
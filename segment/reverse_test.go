@@ -0,0 +1,185 @@
+package segment_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/grapheme"
+	"github.com/npillmayer/uax/internal/ucdparse"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax14"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func ExampleReverseSegmenter() {
+	rev := segment.NewReverseSegmenter(func() uax.UnicodeBreaker {
+		return segment.NewSimpleWordBreaker()
+	})
+	rev.InitFromString("Hello World!")
+	for rev.Previous() {
+		fmt.Printf("'%s'\n", rev.Text())
+	}
+	// Output: 'World!'
+	// ' '
+	// 'Hello'
+}
+
+// reverseTestFile reads a UCD break test file and segments every test case
+// backwards. The resulting segments must match the segments found by
+// forward segmenting. Differences from the expected result given by the
+// test file are counted and returned, leaving it to the caller to decide
+// how many of them are acceptable.
+func reverseTestFile(t *testing.T, filename string, factory segment.BreakerFactory) int {
+	tf := ucdparse.OpenTestFile(filename, t)
+	defer tf.Close()
+	rev := segment.NewReverseSegmenter(factory)
+	failcnt, i := 0, 0
+	for tf.Scan() {
+		i++
+		in, out := ucdparse.BreakTestInput(tf.Text())
+		var forward []string
+		bounds := forwardBoundaries(in, factory)
+		for pos, end := 0, 1; end <= len(in); end++ {
+			if bounds[end] {
+				forward = append(forward, in[pos:end])
+				pos = end
+			}
+		}
+		var backward []string
+		rev.InitFromString(in)
+		for rev.Previous() {
+			backward = append([]string{rev.Text()}, backward...)
+		}
+		if strings.Join(backward, "|") != strings.Join(forward, "|") {
+			t.Errorf("test #%d: backward segments %q differ from forward segments %q",
+				i, backward, forward)
+		}
+		if strings.Join(backward, "|") != strings.Join(out, "|") {
+			failcnt++
+		}
+	}
+	if err := tf.Err(); err != nil {
+		t.Errorf("reading input: %s", err)
+	}
+	t.Logf("%d of %d test cases differ from %s", failcnt, i, filename)
+	return failcnt
+}
+
+func TestReverseGraphemes(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	if n := reverseTestFile(t, "../grapheme/testfile/GraphemeBreakTest.txt", func() uax.UnicodeBreaker {
+		return grapheme.NewBreaker(1)
+	}); n > 0 {
+		t.Errorf("%d test cases differ from expected result", n)
+	}
+}
+
+func TestReverseWords(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	if n := reverseTestFile(t, "../uax29/WordBreakTest.txt", func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	}); n > 0 {
+		t.Errorf("%d test cases differ from expected result", n)
+	}
+}
+
+func TestReverseLines(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	if n := reverseTestFile(t, "../uax14/LineBreakTest.txt", func() uax.UnicodeBreaker {
+		return uax14.NewLineWrap()
+	}); n > 0 {
+		t.Errorf("%d test cases differ from expected result", n)
+	}
+}
+
+func TestReverseSetPosition(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	rev := segment.NewReverseSegmenter(func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+	text := "Delete the previous word"
+	rev.InitFromString(text)
+	rev.SetPosition(19) // after "previous"
+	if !rev.Previous() || rev.Text() != "previous" {
+		t.Errorf("expected previous segment to be 'previous', is %q", rev.Text())
+	}
+	rev.SetPosition(17) // within "previous"
+	if !rev.Previous() || rev.Text() != "previo" {
+		t.Errorf("expected previous segment to be 'previo', is %q", rev.Text())
+	}
+	if !rev.Previous() || rev.Text() != " " {
+		t.Errorf("expected previous segment to be ' ', is %q", rev.Text())
+	}
+}
+
+func TestReverseRegionalIndicators(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	text := "x" + strings.Repeat("🇩🇪", 200) + "abc"
+	for _, factory := range []segment.BreakerFactory{
+		func() uax.UnicodeBreaker { return grapheme.NewBreaker(1) },
+		func() uax.UnicodeBreaker { return uax29.NewWordBreaker(1) },
+	} {
+		bounds := forwardBoundaries(text, factory)
+		rev := segment.NewReverseSegmenter(factory)
+		rev.InitFromString(text)
+		n := 0
+		for rev.Previous() {
+			start, end := rev.ByteOffsets()
+			if !bounds[start] || !bounds[end] {
+				t.Fatalf("segment %q at %d…%d does not match forward boundaries", rev.Text(), start, end)
+			}
+			n++
+		}
+		if n != len(bounds)-1 {
+			t.Errorf("expected %d segments, have %d", len(bounds)-1, n)
+		}
+	}
+}
+
+func TestReverseLongText(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	factory := func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	}
+	for _, text := range []string{
+		strings.Repeat(breakIterText, 50),
+		strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 500),
+	} {
+		bounds := forwardBoundaries(text, factory)
+		rev := segment.NewReverseSegmenter(factory)
+		rev.InitFromString(text)
+		n := 0
+		for rev.Previous() {
+			start, end := rev.ByteOffsets()
+			if !bounds[start] || !bounds[end] {
+				t.Fatalf("segment %d…%d does not match forward boundaries", start, end)
+			}
+			n++
+		}
+		if n != len(bounds)-1 {
+			t.Errorf("expected %d segments, have %d", len(bounds)-1, n)
+		}
+	}
+}
@@ -28,6 +28,20 @@ following breaker is a secondary breaker).
 An example for an UnicodeBreaker is "uax29.WordBreak", a breaker
 implementing the UAX#29 word breaking algorithm.
 
+For text held in memory, clients may range over the segments instead:
+
+    for word := range segment.All(uax29.NewWordBreaker(1), text) {
+       // do something with word
+    }
+
+Secondary breakers may add break opportunities to the ones found by the primary
+breaker. With boundary policy SecondaryVeto, they may suppress breaks as well: if
+the aggregated penalty of all secondary breakers at a position is uax.InfinitePenalty
+or greater, the segmenter will not break there, regardless of the penalty the primary
+breaker reported. Clients choose the policy for which breakers decide about segment
+boundaries with SetBoundaryPolicy or SetBoundaryPredicate. The penalties of each
+breaker at a boundary are available from BreakerPenalties.
+
 _______________________________________________________________________
 
 License
@@ -53,6 +67,8 @@ import (
 	"io"
 	"math"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/npillmayer/schuko/tracing"
 	"github.com/npillmayer/uax"
@@ -79,10 +95,20 @@ type Segmenter struct {
 	deque                      *deque               // where we collect runes and penalties
 	reader                     io.RuneReader        // where we get the next runes from
 	breakers                   []uax.UnicodeBreaker // our work horses
+	typer                      uax.WordTyper        // primary breaker, if it reports word types
+	wordType                   int                  // type of the current segment
 	runesBuf                   runewrite            // rune buffer for segment output (active segement)
 	maxSegmentLen              int                  // maximum length allowed for segments
 	lastPenalties              [2]int               // penalties at last break opportunity
+	lastBreakerPenalties       []int                // penalties per breaker at last break opportunity
+	policy                     BoundaryPolicy       // which breakers decide about boundaries
+	isBoundary                 func([]int) bool     // custom boundary predicate, if any
 	pos                        int                  // current position in input text
+	start, end                 offset               // position of the current segment in the input text
+	srcString                  string               // input text, if initialized with InitFromString
+	srcBytes                   []byte               // input text, if initialized with InitFromBytes
+	src                        inputKind            // which kind of input we read from
+	srcReader                  bytes.Reader         // re-usable reader for InitFromBytes
 	breakOnZero                [2]bool              // treat zero value as a valid breakpoint?
 	longestActiveMatch         int                  // bookkeeping for matching
 	positionOfBreakOpportunity int                  // bookkeeping for matching
@@ -117,6 +143,7 @@ func NewSegmenter(breakers ...uax.UnicodeBreaker) *Segmenter {
 		breakers = []uax.UnicodeBreaker{NewSimpleWordBreaker()}
 	}
 	s.breakers = breakers
+	s.typer, _ = breakers[0].(uax.WordTyper)
 	return s
 }
 
@@ -129,7 +156,7 @@ func (s *Segmenter) Init(reader io.RuneReader) {
 	}
 	s.reader = reader
 	s.runesBuf = s.runesBuf.Reset(nil)
-	s.init()
+	s.init(fromReader)
 }
 
 // InitFromSlice is like Init, except using a slice of runes as an input buffer.
@@ -141,10 +168,44 @@ func (s *Segmenter) InitFromSlice(buf []rune) {
 	}
 	s.reader = &runeread{runes: buf}
 	s.runesBuf = s.runesBuf.Reset(buf)
-	s.init()
+	s.init(fromRunes)
+}
+
+// InitFromString is like Init, except using a string as input.
+// Text() will return substrings of text, without allocating.
+// s is either a newly created segmenter to be initialized, or
+// re-initializes a segmenter already in use.
+func (s *Segmenter) InitFromString(text string) {
+	s.reader = strings.NewReader(text)
+	s.runesBuf = s.runesBuf.Reset(nil)
+	s.init(fromString)
+	s.srcString = text
 }
 
-func (s *Segmenter) init() {
+// InitFromBytes is like Init, except using a byte slice of UTF-8 text as input.
+// Bytes() will return sub-slices of text, without allocating. Clients must not
+// modify text while segmenting.
+// s is either a newly created segmenter to be initialized, or
+// re-initializes a segmenter already in use.
+func (s *Segmenter) InitFromBytes(text []byte) {
+	s.srcReader.Reset(text)
+	s.reader = &s.srcReader
+	s.runesBuf = s.runesBuf.Reset(nil)
+	s.init(fromBytes)
+	s.srcBytes = text
+}
+
+// inputKind tells the kind of input a segmenter has been initialized with.
+type inputKind int8
+
+const (
+	fromReader inputKind = iota
+	fromRunes
+	fromString
+	fromBytes
+)
+
+func (s *Segmenter) init(src inputKind) {
 	if s.deque == nil {
 		s.deque = &deque{} // Q of atoms
 		s.maxSegmentLen = MaxSegmentSize
@@ -154,8 +215,13 @@ func (s *Segmenter) init() {
 		s.atEOF = false
 		s.inUse = false
 		s.lastPenalties[0], s.lastPenalties[1] = 0, 0
+		s.lastBreakerPenalties = s.lastBreakerPenalties[:0]
+		s.wordType = 0
 		s.pos = 0
 	}
+	s.start, s.end = offset{}, offset{}
+	s.src = src
+	s.srcString, s.srcBytes = "", nil
 	s.positionOfBreakOpportunity = -1
 }
 
@@ -235,7 +301,16 @@ func isPossibleBreak(p int, breakOnZero bool) bool {
 // Bytes returns the most recent token generated by a call to Next().
 // The underlying array may point to data that will be overwritten by a
 // subsequent call to Next().
+//
+// If the segmenter has been initialized with a `[]byte` input argument, Bytes() will
+// return a slice of it. No allocation is performed.
 func (s *Segmenter) Bytes() []byte {
+	switch s.src {
+	case fromBytes:
+		return s.srcBytes[s.start.bytes:s.end.bytes]
+	case fromString:
+		return []byte(s.srcString[s.start.bytes:s.end.bytes])
+	}
 	buf := bytes.Buffer{}
 	for _, r := range s.runesBuf.Runes() {
 		buf.WriteRune(r)
@@ -255,10 +330,44 @@ func (s *Segmenter) Runes() []rune {
 
 // Text returns the most recent segment generated by a call to Next()
 // as a newly allocated string holding its bytes.
+//
+// If the segmenter has been initialized with a string input argument, Text() will
+// return a substring of it. No allocation is performed.
 func (s *Segmenter) Text() string {
+	switch s.src {
+	case fromString:
+		return s.srcString[s.start.bytes:s.end.bytes]
+	case fromBytes:
+		return string(s.srcBytes[s.start.bytes:s.end.bytes])
+	}
 	return string(s.runesBuf.Runes())
 }
 
+// ByteOffsets returns the start and end position of the most recent segment
+// generated by a call to Next(), in bytes from the start of the input text.
+// For an input given as a slice of runes, positions are measured in bytes of
+// the UTF-8 encoding of the runes.
+//
+// Positions are counted from the start of the input, not from the position of
+// the io.RuneReader at the time of Init(...).
+func (s *Segmenter) ByteOffsets() (int, int) {
+	return s.start.bytes, s.end.bytes
+}
+
+// RuneOffsets returns the start and end position of the most recent segment
+// generated by a call to Next(), in runes from the start of the input text.
+func (s *Segmenter) RuneOffsets() (int, int) {
+	return s.start.runes, s.end.runes
+}
+
+// UTF16Offsets returns the start and end position of the most recent segment
+// generated by a call to Next(), in UTF-16 code units from the start of the
+// input text. This is useful for clients interfacing with JavaScript, Java or
+// Windows APIs, where string positions are measured in UTF-16.
+func (s *Segmenter) UTF16Offsets() (int, int) {
+	return s.start.utf16, s.end.utf16
+}
+
 // Penalties returns the last penalties a segmenter calculated.
 // Two penalties are returned. The first one is the penalty returned from the
 // primary breaker, the second one is the aggregate of all penalties of all the
@@ -267,6 +376,20 @@ func (s *Segmenter) Penalties() (int, int) {
 	return s.lastPenalties[0], s.lastPenalties[1]
 }
 
+// BreakerPenalties returns the last penalties a segmenter calculated, one for
+// each breaker, in the order the breakers have been given to NewSegmenter.
+// The underlying array will be overwritten by a subsequent call to Next().
+func (s *Segmenter) BreakerPenalties() []int {
+	return s.lastBreakerPenalties
+}
+
+// WordType returns the type of the most recent segment, as reported by the
+// primary breaker. If the primary breaker does not implement uax.WordTyper,
+// WordType returns 0. See uax29.WordBreaker for an example.
+func (s *Segmenter) WordType() int {
+	return s.wordType
+}
+
 // Next gets the next segment, together with the accumulated penalty for this break.
 //
 // Next() advances the Segmenter to the next segment, which will then be available
@@ -398,10 +521,18 @@ func (s *Segmenter) readRune() error {
 	//tracer().P("rune", r).Debugf("--------------------------------------")
 	if err == nil {
 		s.deque.PushBack(r, 0, 0)
+		s.deque.AtomAt(s.deque.Len()-1).resetPenalties(len(s.breakers), 0)
+		if _, ok := s.reader.(*runeread); ok { // runeread reports a size of 1
+			if sz = utf8.RuneLen(r); sz < 0 {
+				sz = utf8.RuneLen(utf8.RuneError)
+			}
+		}
+		s.deque.AtomAt(s.deque.Len() - 1).size = sz
 		return nil
 	}
 	if err == io.EOF {
 		s.deque.PushBack(eotAtom.r, eotAtom.penalty0, eotAtom.penalty1)
+		s.deque.AtomAt(s.deque.Len()-1).resetPenalties(len(s.breakers), uax.InfinitePenalty)
 		s.atEOF = true
 		err = nil
 	} else { // error case, err is non-nil
@@ -422,7 +553,7 @@ func (s *Segmenter) readRune() error {
 // We return ErrBoundReached in this case.
 //
 func (s *Segmenter) readEnoughInputAndFindBreak(bound int) (err error) {
-	// if Q consists just of 0 rune (EOT), do nothing and return false
+	// if Q consists just of the EOT rune, do nothing and return false
 	//tracer().Debugf("segmenter: read enough input, EOF=%v, |Q|=%d", s.atEOF, s.deque.Len())
 	for s.positionOfBreakOpportunity < 0 {
 		if s.pos-s.longestActiveMatch > bound {
@@ -454,14 +585,17 @@ func (s *Segmenter) readEnoughInputAndFindBreak(bound int) (err error) {
 			qlen = s.deque.Len()
 			s.longestActiveMatch = 0
 			r := s.deque.LastRune()
-			for _, breaker := range s.breakers {
+			for i, breaker := range s.breakers {
 				cpClass := breaker.CodePointClassFor(r)
+				if i == 0 && s.typer != nil {
+					s.deque.AtomAt(qlen - 1).wordType = s.typer.CodePointType(r, cpClass)
+				}
 				breaker.StartRulesFor(r, cpClass)
 				breaker.ProceedWithRune(r, cpClass)
 				if lam := breaker.LongestActiveMatch(); lam > s.longestActiveMatch {
 					s.longestActiveMatch = lam
 				}
-				s.insertPenalties(s.inxForBreaker(breaker), breaker.Penalties())
+				s.insertPenalties(i, breaker.Penalties())
 			}
 			//s.printQ()
 			//tracer().Debugf("-- all breakers done --")
@@ -510,7 +644,7 @@ func (s *Segmenter) findBreakOpportunity(to int, boundDist int) int {
 	for ; i < to && i <= boundDist; i++ {
 		//_, p0, p1 := s.deque.At(i)
 		q := s.deque.AtomAt(i)
-		if isPossibleBreak(q.penalty0, s.breakOnZero[0]) || (len(s.breakers) > 1 && isPossibleBreak(q.penalty1, s.breakOnZero[1])) {
+		if s.isPossibleBoundary(q) {
 			breakopp = i
 			//tracer().Debugf("segmenter: penalties[%#U] = %d|%d   --- 8< ---", j, p0, p1)
 			break
@@ -528,19 +662,11 @@ func (s *Segmenter) findBreakOpportunity(to int, boundDist int) int {
 	return breakopp
 }
 
-// find out if the UnicodeBreaker b is the primary breaker. If yes, return index 0,
-// 1 otherwise.
-func (s *Segmenter) inxForBreaker(b uax.UnicodeBreaker) int {
-	if b == s.breakers[0] {
-		return 0
-	}
-	return 1
-}
-
 // insertPenalties distributes penalties from the current breaker cycle
 // between the Q atoms. Penalties may be located after the fact between
-// runes of the current longest match.
-func (s *Segmenter) insertPenalties(selector int, penalties []int) {
+// runes of the current longest match. Penalties of the breaker with index
+// inx are aggregated as primary (inx = 0) or secondary penalties.
+func (s *Segmenter) insertPenalties(inx int, penalties []int) {
 	l := s.deque.Len()
 	if len(penalties) > l {
 		penalties = penalties[:l] // drop excessive penalties
@@ -551,7 +677,8 @@ func (s *Segmenter) insertPenalties(selector int, penalties []int) {
 	for i, p := range penalties {
 		at := l - i
 		atom := s.deque.AtomAt(at)
-		if selector == 0 {
+		atom.penalties[inx] += p
+		if inx == 0 {
 			atom.penalty0 += p
 		} else {
 			atom.penalty1 += p
@@ -579,6 +706,7 @@ func (s *Segmenter) insertPenalties(selector int, penalties []int) {
 func (s *Segmenter) getFrontSegment(bound int) (int, bool) {
 	seglen := 0
 	s.lastPenalties[0], s.lastPenalties[1] = 0, 0
+	s.wordType = 0
 	s.runesBuf = s.runesBuf.SetMark()
 	l := min(s.deque.Len()-1, s.positionOfBreakOpportunity)
 	if l < 0 {
@@ -594,7 +722,11 @@ func (s *Segmenter) getFrontSegment(bound int) (int, bool) {
 			return 0, true
 		}
 	}
+	s.start = s.end
 	for i := 0; i <= l; i++ {
+		s.end.advance(s.deque.AtomAt(0))
+		s.lastBreakerPenalties = append(s.lastBreakerPenalties[:0], s.deque.AtomAt(0).penalties...)
+		s.wordType = max(s.wordType, s.deque.AtomAt(0).wordType)
 		r, p0, p1 := s.deque.PopFront()
 		written, _ := (&s.runesBuf).WriteRune(r)
 		seglen += written
@@ -656,6 +788,24 @@ func bounded(p int) int {
 	return p
 }
 
+// offset is a position in the input text, measured in different units.
+type offset struct {
+	bytes, runes, utf16 int
+}
+
+// advance moves an offset past the rune of a Q atom.
+func (o *offset) advance(a *atom) {
+	if a.size == 0 { // eot is not part of the input
+		return
+	}
+	o.bytes += a.size
+	o.runes++
+	o.utf16++
+	if a.r >= 0x10000 && a.r <= unicode.MaxRune { // encoded as a surrogate pair
+		o.utf16++
+	}
+}
+
 // runeread is a helper to wrap a `[]rune` into a cheap RuneReader.
 type runeread struct {
 	runes []rune
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"unicode/utf16"
 
 	"github.com/npillmayer/schuko/tracing"
 	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
 )
 
 func init() {
@@ -169,6 +171,82 @@ func TestRunesSlicing(t *testing.T) {
 	}
 }
 
+func TestZeroCopySegments(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	text := "Grüße aus 🇩🇪 und 𝄞 Musik!"
+	input := []byte(text)
+	seg := NewSegmenter(NewSimpleWordBreaker())
+	seg.InitFromBytes(input)
+	var out []string
+	for seg.Next() {
+		if allocs := testing.AllocsPerRun(10, func() { seg.Bytes() }); allocs > 0 {
+			t.Errorf("expected Bytes() not to allocate, has %.0f allocations", allocs)
+		}
+		start, _ := seg.ByteOffsets()
+		if &seg.Bytes()[0] != &input[start] {
+			t.Errorf("expected Bytes() to return a sub-slice of the input")
+		}
+		out = append(out, string(seg.Bytes()))
+	}
+	seg.InitFromString(text)
+	i := 0
+	for seg.Next() {
+		if allocs := testing.AllocsPerRun(10, func() { _ = seg.Text() }); allocs > 0 {
+			t.Errorf("expected Text() not to allocate, has %.0f allocations", allocs)
+		}
+		if i >= len(out) || seg.Text() != out[i] {
+			t.Errorf("string and bytes input produce different segments")
+		}
+		i++
+	}
+	if i != len(out) || strings.Join(out, "") != text {
+		t.Errorf("expected segments to make up the input, have %q", out)
+	}
+}
+
+func TestSegmentOffsets(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	text := "Grüße aus 🇩🇪 und 𝄞 Musik!"
+	runes := []rune(text)
+	units := utf16.Encode(runes)
+	check := func(seg *Segmenter) {
+		n, last := 0, 0
+		for seg.Next() {
+			bstart, bend := seg.ByteOffsets()
+			rstart, rend := seg.RuneOffsets()
+			ustart, uend := seg.UTF16Offsets()
+			if bstart != last {
+				t.Errorf("segment %q does not start at end of previous segment", seg.Text())
+			}
+			last = bend
+			if text[bstart:bend] != seg.Text() {
+				t.Errorf("byte offsets %d…%d do not match segment %q", bstart, bend, seg.Text())
+			}
+			if string(runes[rstart:rend]) != seg.Text() {
+				t.Errorf("rune offsets %d…%d do not match segment %q", rstart, rend, seg.Text())
+			}
+			if string(utf16.Decode(units[ustart:uend])) != seg.Text() {
+				t.Errorf("UTF-16 offsets %d…%d do not match segment %q", ustart, uend, seg.Text())
+			}
+			n++
+		}
+		if n != 11 {
+			t.Errorf("Expected 11 segments, have %d", n)
+		}
+	}
+	seg := NewSegmenter(NewSimpleWordBreaker())
+	seg.Init(strings.NewReader(text))
+	check(seg)
+	seg.InitFromSlice(runes)
+	check(seg)
+}
+
 func ExampleSegmenter() {
 	seg := NewSegmenter() // will use a SimpleWordBreaker
 	seg.Init(strings.NewReader("Hello World!"))
@@ -186,6 +264,8 @@ func ExampleSegmenter() {
 // --- Profiling -------------------------------------------------------------
 
 var p1, p2 int
+var segText string
+var segBytes []byte
 
 func BenchmarkBytesSegmenter(b *testing.B) {
 	seg := NewSegmenter() // will use a SimpleWordBreaker
@@ -208,6 +288,51 @@ func BenchmarkRunesSegmenter(b *testing.B) {
 	}
 }
 
+func BenchmarkReaderSegmenterBytes(b *testing.B) {
+	b.ReportAllocs()
+	seg := NewSegmenter() // will use a SimpleWordBreaker
+	for i := 0; i < b.N; i++ {
+		seg.Init(strings.NewReader(corpus))
+		for seg.Next() {
+			segBytes = seg.Bytes()
+		}
+	}
+}
+
+func BenchmarkBytesInputSegmenter(b *testing.B) {
+	b.ReportAllocs()
+	input := []byte(corpus)
+	seg := NewSegmenter() // will use a SimpleWordBreaker
+	for i := 0; i < b.N; i++ {
+		seg.InitFromBytes(input)
+		for seg.Next() {
+			segBytes = seg.Bytes()
+		}
+	}
+}
+
+func BenchmarkReaderSegmenterText(b *testing.B) {
+	b.ReportAllocs()
+	seg := NewSegmenter() // will use a SimpleWordBreaker
+	for i := 0; i < b.N; i++ {
+		seg.Init(strings.NewReader(corpus))
+		for seg.Next() {
+			segText = seg.Text()
+		}
+	}
+}
+
+func BenchmarkStringInputSegmenter(b *testing.B) {
+	b.ReportAllocs()
+	seg := NewSegmenter() // will use a SimpleWordBreaker
+	for i := 0; i < b.N; i++ {
+		seg.InitFromString(corpus)
+		for seg.Next() {
+			segText = seg.Text()
+		}
+	}
+}
+
 func BenchmarkScanSplit(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		corpusReader := strings.NewReader(corpus)
@@ -221,6 +346,22 @@ func BenchmarkScanSplit(b *testing.B) {
 	}
 }
 
+func BenchmarkScanSplitFunc(b *testing.B) {
+	split := SplitFunc(func() uax.UnicodeBreaker {
+		return NewSimpleWordBreaker()
+	})
+	for i := 0; i < b.N; i++ {
+		corpusReader := strings.NewReader(corpus)
+		scan := bufio.NewScanner(corpusReader)
+		scan.Split(split)
+		for scan.Scan() {
+			if len(scan.Bytes()) > 0 {
+				p1 = len(scan.Bytes())
+			}
+		}
+	}
+}
+
 var corpus = `
 Im deutschen Grundgesetz ist der soziale Gedanke grundlegend verankert und sogar vor Änderungen geschützt. In politischen Diskussionen ist der Begriff bei uns durchgehend positiv besetzt, und dementsprechend wird er von Vertretern des gesamten politischen Spektrums vereinnahmt und gedeutet. Daran zeigt sich auch, dass der Begriff keineswegs einheitlich verstanden wird: Die soziale Gerechtigkeit des einen ist ungerecht aus Sicht des anderen.
 Soziale Gerechtigkeit ist nicht gleichbedeutend mit vollständiger Gleichheit. In Deutschland folgen wir im Großen und Ganzen der Denkrichtung einer sozial-liberalen Gerechtigkeit, wie sie u.a. auf John Rawls zurück geht. Dabei akzeptieren wir Ungleichheiten, wie sie durch Glück, Leistung, Genetik usw. zustande kommen, bejahen aber auch ein Recht des Staats zur Umverteilung für gesamtgesellschaftliche Ziele.
@@ -1,6 +1,10 @@
 package segment
 
-import "unicode"
+import (
+	"unicode"
+
+	"github.com/npillmayer/uax"
+)
 
 // SimpleWordBreaker is a UnicodeBreaker which breaks at whitespace.
 // Whitespace is determined by unicode.IsSpace(r) for any rune.
@@ -43,7 +47,7 @@ func (swb *SimpleWordBreaker) CodePointClassFor(r rune) int {
 	if unicode.IsSpace(r) {
 		return spaceType
 	}
-	if r == 0 {
+	if r == uax.EOT {
 		return eofType
 	}
 	return wordType
@@ -58,7 +62,7 @@ func (swb *SimpleWordBreaker) StartRulesFor(rune, int) {
 
 // ProceedWithRune is part of interface UnicodeBreaker
 func (swb *SimpleWordBreaker) ProceedWithRune(r rune, cpClass int) {
-	if r != 0 && cpClass == swb.matchType {
+	if r != uax.EOT && cpClass == swb.matchType {
 		swb.penalties = swb.penalties[:0]
 		swb.matchLen[swb.matchType]++
 		return
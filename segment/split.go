@@ -0,0 +1,106 @@
+package segment
+
+import (
+	"bufio"
+	"sync"
+	"unicode/utf8"
+)
+
+// SplitFunc creates a split function for a bufio.Scanner from one or more
+// breaker factories. The first factory creates the primary breaker, any further
+// factories create secondary breakers (see NewSegmenter). The split function
+// returns the segments a Segmenter with these breakers would return, as tokens:
+//
+//   scanner := bufio.NewScanner(r)
+//   scanner.Split(segment.SplitFunc(func() uax.UnicodeBreaker {
+//       return uax29.NewWordBreaker(1)
+//   }))
+//   for scanner.Scan() {
+//       // do something with scanner.Text()
+//   }
+//
+// Breakers may need to look ahead an arbitrary number of runes to decide
+// about a break. If a break cannot be decided before the end of the data the
+// scanner currently holds, the split function will request more data. Only at the
+// end of the input (atEOF) will a break be placed at the end of the data.
+// Scanners limit the size of their buffer (see bufio.Scanner.Buffer), so a
+// lookahead larger than the buffer will result in bufio.ErrTooLong.
+//
+// Unlike a Segmenter, the split function will return the end of the input as a
+// final token, even if no breaker puts a break there.
+//
+// The split function does not keep any state between calls and may be shared
+// between scanners, just like the split functions of package bufio. For every
+// token the factories are called to create fresh breakers, which therefore
+// see the start of a token as the start of the text (sot). A breaker with rules
+// looking behind a boundary may thus find different boundaries than a Segmenter
+// working on the whole text. The rules of UAX#14 and UAX#29 are not affected:
+// where they look behind, as LB15a and LB20a do, sot is treated like the
+// code-points a boundary may follow. Clients of other breakers relying on
+// context across boundaries should use a Segmenter.
+func SplitFunc(factories ...BreakerFactory) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		return split(factories, data, atEOF)
+	}
+}
+
+// segmenterPool holds segmenters for split functions, to avoid allocating
+// buffers for every token.
+var segmenterPool = sync.Pool{
+	New: func() interface{} {
+		return &Segmenter{}
+	},
+}
+
+func split(factories []BreakerFactory, data []byte, atEOF bool) (int, []byte, error) {
+	if len(data) == 0 {
+		return 0, nil, nil
+	}
+	seg := segmenterPool.Get().(*Segmenter)
+	defer segmenterPool.Put(seg)
+	seg.breakers = seg.breakers[:0]
+	for _, f := range factories {
+		seg.breakers = append(seg.breakers, f())
+	}
+	if len(seg.breakers) == 0 {
+		seg.breakers = append(seg.breakers, NewSimpleWordBreaker())
+	}
+	seg.err = nil
+	if !atEOF {
+		// Do not let the segmenter see a rune which has not been read completely.
+		// It would be mistaken as an illegal byte sequence.
+		data = data[:completeRunes(data)]
+	}
+	seg.InitFromBytes(data)
+	defer seg.InitFromBytes(nil) // do not hold on to data
+	if seg.Next() {
+		// If the segmenter had to read up to the end of data to find the break,
+		// the break may change with more input.
+		if atEOF || !seg.atEOF {
+			_, end := seg.ByteOffsets()
+			return end, data[:end], nil
+		}
+		return 0, nil, nil
+	}
+	if err := seg.Err(); err != nil {
+		return 0, nil, err
+	}
+	if atEOF { // no break before the end of the input
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// completeRunes returns the length of the longest prefix of data which does
+// not end with an incomplete UTF-8 encoded rune.
+func completeRunes(data []byte) int {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if utf8.FullRune(data[i:]) {
+				return len(data)
+			}
+			return i
+		}
+	}
+	return len(data)
+}
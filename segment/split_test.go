@@ -0,0 +1,171 @@
+package segment_test
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/grapheme"
+	"github.com/npillmayer/uax/internal/ucdparse"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax14"
+	"github.com/npillmayer/uax/uax29"
+)
+
+// checkSplit scans a text and compares the tokens to the segments found
+// by a segmenter. The reader delivers one byte at a time, forcing the split
+// function to request more data at every possible position.
+func checkSplit(t *testing.T, text string, split bufio.SplitFunc, factory segment.BreakerFactory) {
+	expected := forwardSegments(text, factory)
+	tokens, err := splitTokens(iotest.OneByteReader(strings.NewReader(text)), split)
+	if err != nil {
+		t.Fatalf("scanner error: %v", err)
+	}
+	if strings.Join(tokens, "|") != strings.Join(expected, "|") {
+		t.Errorf("tokens differ from segments:\n%q\n%q", tokens, expected)
+	}
+}
+
+// forwardSegments gets all segments of a text by segmenting from the start.
+func forwardSegments(text string, factory segment.BreakerFactory) []string {
+	bounds := forwardBoundaries(text, factory)
+	var segments []string
+	for pos, end := 0, 1; end <= len(text); end++ {
+		if bounds[end] {
+			segments = append(segments, text[pos:end])
+			pos = end
+		}
+	}
+	return segments
+}
+
+func splitTokens(r io.Reader, split bufio.SplitFunc) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+	var tokens []string
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	return tokens, scanner.Err()
+}
+
+// splitTestFile scans every test case of a UCD break test file and compares
+// the tokens to the segments found by a segmenter. The split function starts
+// fresh breakers for every token, while the segmenter keeps its breakers
+// running. Differences are counted and returned.
+func splitTestFile(t *testing.T, filename string, split bufio.SplitFunc, factory segment.BreakerFactory) int {
+	tf := ucdparse.OpenTestFile(filename, t)
+	defer tf.Close()
+	failcnt, i := 0, 0
+	for tf.Scan() {
+		i++
+		in, _ := ucdparse.BreakTestInput(tf.Text())
+		expected := forwardSegments(in, factory)
+		tokens, err := splitTokens(strings.NewReader(in), split)
+		if err != nil {
+			t.Fatalf("test #%d: scanner error: %v", i, err)
+		}
+		if strings.Join(tokens, "|") != strings.Join(expected, "|") {
+			t.Logf("test #%d: tokens %q differ from segments %q", i, tokens, expected)
+			failcnt++
+		}
+	}
+	if err := tf.Err(); err != nil {
+		t.Errorf("reading input: %s", err)
+	}
+	return failcnt
+}
+
+func TestSplitSimpleWords(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	factory := func() uax.UnicodeBreaker {
+		return segment.NewSimpleWordBreaker()
+	}
+	checkSplit(t, breakIterText, segment.SplitFunc(factory), factory)
+}
+
+func TestSplitGraphemes(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	checkSplit(t, breakIterText, grapheme.ScanGraphemes, func() uax.UnicodeBreaker {
+		return grapheme.NewBreaker(1)
+	})
+}
+
+func TestSplitWords(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	checkSplit(t, breakIterText, uax29.ScanWords, func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+}
+
+func TestSplitLineBreakOpportunities(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	checkSplit(t, breakIterText, uax14.ScanLineBreakOpportunities, func() uax.UnicodeBreaker {
+		return uax14.NewLineWrap()
+	})
+}
+
+func TestSplitLookahead(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	// Regional indicators pair up from the start of a run, so the grapheme break
+	// after an odd number of flags depends on the text far before it.
+	// Word rule WB6/WB7 needs lookahead after "3." in "3.14".
+	checkSplit(t, strings.Repeat("🇩🇪", 20)+"🇫", grapheme.ScanGraphemes, func() uax.UnicodeBreaker {
+		return grapheme.NewBreaker(1)
+	})
+	checkSplit(t, "a.b 3.14 x.", uax29.ScanWords, func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	})
+}
+
+func TestSplitRestartAtTokens(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	// Every token starts with fresh breakers. Rules LB15a and LB20a look
+	// behind the start of a token, which a fresh breaker sees as sot.
+	for _, text := range []string{"a  “  b", "\uFFFC“ b", "x -y", "\uFFFC-b"} {
+		checkSplit(t, text, uax14.ScanLineBreakOpportunities, func() uax.UnicodeBreaker {
+			return uax14.NewLineWrap()
+		})
+	}
+	if n := splitTestFile(t, "../grapheme/testfile/GraphemeBreakTest.txt", grapheme.ScanGraphemes,
+		func() uax.UnicodeBreaker {
+			return grapheme.NewBreaker(1)
+		}); n > 0 {
+		t.Errorf("%d grapheme test cases split differently", n)
+	}
+	if n := splitTestFile(t, "../uax29/WordBreakTest.txt", uax29.ScanWords,
+		func() uax.UnicodeBreaker {
+			return uax29.NewWordBreaker(1)
+		}); n > 0 {
+		t.Errorf("%d word test cases split differently", n)
+	}
+	if n := splitTestFile(t, "../uax14/LineBreakTest.txt", uax14.ScanLineBreakOpportunities,
+		func() uax.UnicodeBreaker {
+			return uax14.NewLineWrap()
+		}); n > 0 {
+		t.Errorf("%d line test cases split differently", n)
+	}
+}
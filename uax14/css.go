@@ -0,0 +1,174 @@
+package uax14
+
+// --- CSS tailorings --------------------------------------------------------
+
+// Option configures a LineWrap.
+type Option func(lw *LineWrap)
+
+// LineBreakMode is a tailoring of the strictness of line breaking, following
+// the CSS property `line-break` (CSS Text Module Level 3, section 5.3).
+// It is mainly concerned with line breaking in Chinese and Japanese text.
+type LineBreakMode int
+
+// Modes of the CSS property `line-break`. CSS value `auto` is
+// equivalent to LineBreakNormal.
+//
+// UAX#14 resolves class CJ (small kana and the prolonged sound mark) to NS,
+// i.e. its default is LineBreakStrict. LineBreakNormal and LineBreakLoose
+// resolve CJ to ID, allowing breaks before small kana. LineBreakLoose additionally
+// allows breaks before iteration marks, around centered punctuation and
+// before fullwidth postfix and after fullwidth prefix characters.
+//
+// CSS restricts some of these tailorings to Chinese and Japanese text. A
+// LineWrap does not know about the language of a text and applies them to
+// any text. Breaks between inseparable characters (class IN), which loose
+// line breaking allows for CJK text, are not tailored, as this would
+// affect ellipses in western text.
+//
+// LineBreakAnywhere puts a break opportunity between any two characters, except
+// within combining character sequences and between CR and LF, regardless of
+// any rule prohibiting a break. Mandatory breaks are still honoured.
+const (
+	LineBreakStrict LineBreakMode = iota
+	LineBreakNormal
+	LineBreakLoose
+	LineBreakAnywhere
+)
+
+func (mode LineBreakMode) String() string {
+	switch mode {
+	case LineBreakStrict:
+		return "strict"
+	case LineBreakNormal:
+		return "normal"
+	case LineBreakLoose:
+		return "loose"
+	case LineBreakAnywhere:
+		return "anywhere"
+	}
+	return "?"
+}
+
+// WordBreakMode is a tailoring of line breaking within words, following the
+// CSS property `word-break` (CSS Text Module Level 3, section 5.2).
+type WordBreakMode int
+
+// Modes of the CSS property `word-break`.
+//
+// WordBreakBreakAll allows breaks between letters and digits, as if they were
+// ideographs: classes AL, HL, NU, H2 and H3 (and classes resolved to AL, like
+// SA and AI) are resolved to ID.
+//
+// WordBreakKeepAll prohibits breaks between ideographs and Hangul, as if they were
+// letters of western words: classes ID, H2, H3, JL, JV, JT and CJ are resolved to
+// AL. This is mainly used for Korean text, which is written with spaces between
+// words.
+const (
+	WordBreakNormal WordBreakMode = iota
+	WordBreakKeepAll
+	WordBreakBreakAll
+)
+
+func (mode WordBreakMode) String() string {
+	switch mode {
+	case WordBreakNormal:
+		return "normal"
+	case WordBreakKeepAll:
+		return "keep-all"
+	case WordBreakBreakAll:
+		return "break-all"
+	}
+	return "?"
+}
+
+// CSSLineBreak sets the tailoring of the CSS property `line-break`.
+// The default is LineBreakStrict, as defined by UAX#14.
+func CSSLineBreak(mode LineBreakMode) Option {
+	return func(lw *LineWrap) {
+		lw.lineBreak = mode
+	}
+}
+
+// CSSWordBreak sets the tailoring of the CSS property `word-break`.
+// The default is WordBreakNormal.
+func CSSWordBreak(mode WordBreakMode) Option {
+	return func(lw *LineWrap) {
+		lw.wordBreak = mode
+	}
+}
+
+// tailorClass resolves code-point classes according to the CSS properties
+// `line-break` and `word-break`. c has been resolved by rule LB1 without the
+// resolution of class CJ.
+func (uax14 *LineWrap) tailorClass(r rune, c UAX14Class) UAX14Class {
+	cj := (c == CJClass)
+	switch c {
+	case CJClass:
+		if uax14.lineBreak == LineBreakStrict {
+			c = NSClass
+		} else {
+			c = IDClass
+		}
+	case NSClass:
+		if uax14.lineBreak == LineBreakLoose && isLooseNS(r) {
+			c = IDClass
+		}
+	case POClass, PRClass:
+		if uax14.lineBreak == LineBreakLoose && isFullwidth(r) {
+			c = IDClass
+		}
+	}
+	switch uax14.wordBreak {
+	case WordBreakBreakAll:
+		switch c {
+		case ALClass, HLClass, NUClass, H2Class, H3Class:
+			c = IDClass
+		}
+	case WordBreakKeepAll:
+		switch c {
+		case IDClass, H2Class, H3Class, JLClass, JVClass, JTClass:
+			c = ALClass
+		default:
+			if cj { // small kana are letters as well
+				c = ALClass
+			}
+		}
+	}
+	return c
+}
+
+// isLooseNS checks for code-points of class NS, which loose line breaking
+// allows to break before: iteration marks and centered punctuation.
+func isLooseNS(r rune) bool {
+	switch r {
+	case '々', '〻', 'ゝ', 'ゞ', 'ヽ', 'ヾ', // iteration marks
+		'・', '：', '；', '･', '‼', '⁇', '⁈', '⁉': // centered punctuation
+		return true
+	}
+	return false
+}
+
+// isFullwidth checks for fullwidth forms of ASCII characters (U+FF01…U+FF5E) and
+// fullwidth signs (U+FFE0…U+FFE6).
+func isFullwidth(r rune) bool {
+	return r >= 0xFF01 && r <= 0xFF5E || r >= 0xFFE0 && r <= 0xFFE6
+}
+
+// breakAnywhere lifts all prohibitions of breaks for CSS `line-break: anywhere`.
+// Only breaks within combining character sequences (rule LB9) and between CR
+// and LF are still suppressed.
+func (uax14 *LineWrap) breakAnywhere(c UAX14Class, x []int) []int {
+	keep := uax14.substituted && uax14.lastClass == c || // LB9
+		uax14.shadow == LFClass && uax14.lastClass == CRClass
+	for i, p := range x {
+		if p > 0 && !(i == 1 && keep) {
+			x[i] = 0
+		}
+	}
+	if keep {
+		x = setPenalty1(x, noBreak)
+	} else {
+		x = setPenalty1(x, uax14.profile.Default)
+	}
+	return x
+}
@@ -38,11 +38,14 @@ import (
 	"runtime"
 	"text/template"
 	"time"
+	"unicode"
 
 	"os"
 
 	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/npillmayer/uax/internal/classtable"
 	"github.com/npillmayer/uax/internal/ucdparse"
+	"golang.org/x/text/unicode/rangetable"
 )
 
 var logger = log.New(os.Stderr, "UAX#14 generator: ", log.LstdFlags)
@@ -50,10 +53,14 @@ var logger = log.New(os.Stderr, "UAX#14 generator: ", log.LstdFlags)
 // flag: verbose output ?
 var verbose bool
 
-var uax14classnames = []string{"AI", "AL", "B2", "BA", "BB", "BK", "CB", "CJ",
-	"CL", "CM", "CP", "CR", "EB", "EM", "EX", "GL", "H2", "H3", "HL", "HY",
-	"ID", "IN", "IS", "JL", "JT", "JV", "LF", "NL", "NS", "NU", "OP", "PO",
-	"PR", "QU", "RI", "SA", "SG", "SP", "SY", "WJ", "XX", "ZW", "ZWJ"}
+// Classes AK, AP, AS, VF and VI have been introduced with Unicode 15.1,
+// class HH with Unicode 16.0.
+// If LineBreak.txt is of an older version, they will be empty.
+var uax14classnames = []string{"AI", "AK", "AL", "AP", "AS", "B2", "BA", "BB",
+	"BK", "CB", "CJ", "CL", "CM", "CP", "CR", "EB", "EM", "EX", "GL", "H2", "H3",
+	"HH", "HL", "HY", "ID", "IN", "IS", "JL", "JT", "JV", "LF", "NL", "NS", "NU",
+	"OP", "PO", "PR", "QU", "RI", "SA", "SG", "SP", "SY", "VF", "VI", "WJ", "XX",
+	"ZW", "ZWJ"}
 
 // Load the Unicode UAX#14 definition file: LineBreak.txt
 func loadUnicodeLineBreakFile() (map[string][]rune, error) {
@@ -76,6 +83,9 @@ func loadUnicodeLineBreakFile() (map[string][]rune, error) {
 	for p.Next() {
 		from, to := p.Token.Range()
 		brclzstr := p.Token.Field(1)
+		if brclzstr == "" { // not a data item, e.g. document root
+			continue
+		}
 		list := lbcs[brclzstr]
 		if list == nil {
 			list = arraylist.New()
@@ -115,6 +125,7 @@ import (
     "strconv"
     "unicode"
 
+    "github.com/npillmayer/uax/internal/classtable"
     "golang.org/x/text/unicode/rangetable"
 )
 `
@@ -211,7 +222,8 @@ func generateRanges(w *bufio.Writer, codePointLists map[string][]rune) {
 	w.WriteString("\nfunc setupUAX14Classes() {\n")
 	w.WriteString("    rangeFromUAX14Class = make([]*unicode.RangeTable, int(ZWJClass)+1)\n")
 	t := makeTemplate("UAX#14 range", templateRangeForClass)
-	for key, codepoints := range codePointLists {
+	for _, key := range uax14classnames { // classes missing from LineBreak.txt get empty tables
+		codepoints := codePointLists[key]
 		w.WriteString(fmt.Sprintf("\n    // Range for UAX#14 class %s\n", key))
 		w.WriteString(fmt.Sprintf("    %s = rangetable.New(", key))
 		checkFatal(t.Execute(w, codepoints))
@@ -221,6 +233,21 @@ func generateRanges(w *bufio.Writer, codePointLists map[string][]rune) {
 	w.WriteString("}\n")
 }
 
+// generateLookupTable writes a two-stage lookup table for all classes, to be
+// used by the ClassForRune function.
+func generateLookupTable(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate lookup table")
+	classes := make([]*unicode.RangeTable, len(uax14classnames))
+	for i, key := range uax14classnames {
+		if codepoints, ok := codePointLists[key]; ok {
+			classes[i] = rangetable.New(codepoints...)
+		}
+	}
+	t, err := classtable.Build(classes)
+	checkFatal(err)
+	checkFatal(t.WriteGo(w, "uax14ClassTable"))
+}
+
 func main() {
 	doVerbose := flag.Bool("v", false, "verbose output mode")
 	flag.Parse()
@@ -243,6 +270,7 @@ func main() {
 	t = makeTemplate("UAX#14 classes stringer", templateClassStringer)
 	checkFatal(t.Execute(w, uax14classnames))
 	generateRanges(w, codePointLists)
+	generateLookupTable(w, codePointLists)
 	w.Flush()
 }
 
@@ -0,0 +1,116 @@
+package uax14
+
+// --- Kinsoku shori ---------------------------------------------------------
+
+// Kinsoku is a set of rules for line breaking in Japanese text (kinsoku shori,
+// “processing of prohibited characters”): some characters must not start a line,
+// others must not end a line. Clients may use one of the predefined sets
+// (StandardKinsoku and WeakKinsoku) or define their own.
+//
+// Many of the rules are covered by UAX#14 already, e.g. closing brackets will
+// never start a line. Other rules are stricter than UAX#14: depending on the
+// tailoring (see CSSLineBreak), UAX#14 allows breaks before small kana and the
+// prolonged sound mark. Kinsoku rules are not enforced by suppressing breaks,
+// but by adding Penalty to the penalty of a break which would violate a rule.
+// This lets a paragraph breaker decide to violate a rule if all other breaks are
+// worse.
+type Kinsoku struct {
+	NotStarting string // characters which should not start a line
+	NotEnding   string // characters which should not end a line
+	Penalty     int    // penalty for violating a rule
+}
+
+// PenaltyForKinsoku is the default penalty for violating a kinsoku rule. It is
+// the penalty of the predefined sets of rules; clients may change it in a set
+// before handing the set to KinsokuShori.
+const PenaltyForKinsoku = 1000
+
+// Character sets for kinsoku rules.
+const (
+	kinsokuClosing     = ")]}）］｝〕〉》」』】〙〗〟’”｠»｣"
+	kinsokuPunctuation = "、。，．・：；？！‼⁇⁈⁉,.:;?!｡､･"
+	kinsokuIteration   = "ヽヾゝゞ々〻"
+	kinsokuSmallKana   = "ぁぃぅぇぉっゃゅょゎゕゖァィゥェォッャュョヮヵヶㇰㇱㇲㇳㇴㇵㇶㇷㇸㇹㇺㇻㇼㇽㇾㇿｧｨｩｪｫｯｬｭｮ"
+	kinsokuProlonged   = "ーｰ"
+	kinsokuOpening     = "([{（［｛〔〈《「『【〘〖〝‘“｟«｢"
+)
+
+// StandardKinsoku returns the standard set of kinsoku rules: lines should not
+// start with closing brackets, punctuation, iteration marks, small kana or the
+// prolonged sound mark, and should not end with opening brackets.
+//
+// Each call returns a new set, so clients are free to modify it.
+func StandardKinsoku() *Kinsoku {
+	return &Kinsoku{
+		NotStarting: kinsokuClosing + kinsokuPunctuation + kinsokuIteration +
+			kinsokuSmallKana + kinsokuProlonged,
+		NotEnding: kinsokuOpening,
+		Penalty:   PenaltyForKinsoku,
+	}
+}
+
+// WeakKinsoku returns a weak set of kinsoku rules: lines should not start with
+// closing brackets or punctuation, and should not end with opening brackets.
+// Small kana, iteration marks and the prolonged sound mark may start a line.
+//
+// Each call returns a new set, so clients are free to modify it.
+func WeakKinsoku() *Kinsoku {
+	return &Kinsoku{
+		NotStarting: kinsokuClosing + kinsokuPunctuation,
+		NotEnding:   kinsokuOpening,
+		Penalty:     PenaltyForKinsoku,
+	}
+}
+
+// KinsokuShori sets kinsoku rules for a LineWrap. The rules are copied, thus
+// changing them afterwards has no effect on the LineWrap.
+func KinsokuShori(rules *Kinsoku) Option {
+	return func(lw *LineWrap) {
+		if rules == nil {
+			lw.kinsoku = nil
+			return
+		}
+		k := &kinsoku{
+			notStarting: make(map[rune]struct{}),
+			notEnding:   make(map[rune]struct{}),
+			penalty:     rules.Penalty,
+		}
+		for _, r := range rules.NotStarting {
+			k.notStarting[r] = struct{}{}
+		}
+		for _, r := range rules.NotEnding {
+			k.notEnding[r] = struct{}{}
+		}
+		lw.kinsoku = k
+	}
+}
+
+// kinsoku holds the kinsoku rules of a LineWrap and the last character which
+// would end a line if we would break before the current one.
+type kinsoku struct {
+	notStarting map[rune]struct{}
+	notEnding   map[rune]struct{}
+	penalty     int
+	last        rune // last code-point, not counting spaces
+}
+
+// apply adds penalties for violating kinsoku rules to the position before r.
+// Positions where breaking is of no concern are left untouched.
+func (k *kinsoku) apply(r rune, c UAX14Class, x []int) []int {
+	p := 0
+	if _, ok := k.notStarting[r]; ok {
+		p += k.penalty
+	}
+	if _, ok := k.notEnding[k.last]; ok && c != SPClass {
+		p += k.penalty
+	}
+	if c == eot {
+		k.last = 0
+	} else if c != SPClass {
+		k.last = r
+	}
+	if p != 0 && len(x) > 1 && x[1] != 0 {
+		x[1] += p
+	}
+	return x
+}
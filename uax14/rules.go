@@ -1,6 +1,10 @@
 package uax14
 
-import "github.com/npillmayer/uax"
+import (
+	"unicode"
+
+	"github.com/npillmayer/uax"
+)
 
 /*
 BSD License
@@ -58,44 +62,50 @@ OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 11.01		×	WJ
 11.02	WJ	×
 12.0	GL	×
-12.1	[^ SP BA HY CM]	×	GL
-12.2	[^ BA HY CM] CM+	×	GL
+12.1	[^ SP BA HY HH CM]	×	GL
+12.2	[^ BA HY HH CM] CM+	×	GL
 12.3	^ CM+	×	GL
 13.01		×	EX
 13.02	[^ NU CM]	×	(CL | CP | IS | SY)
 13.03	[^ NU CM] CM+	×	(CL | CP | IS | SY)
 13.04	^ CM+	×	(CL | CP | IS | SY)
 14.0	OP SP*	×
-15.0	QU SP*	×	OP
+15.1	(sot | BK | CR | LF | NL | OP | QU | GL | SP | ZW) [\p{Pi}&QU] SP*	×
+15.2		×	[\p{Pf}&QU] ( SP | GL | WJ | CL | QU | CP | EX | IS | SY | BK | CR | LF | NL | ZW | eot)
+15.3	SP	÷	IS NU
+15.4		×	IS
 16.0	(CL | CP) SP*	×	NS
 17.0	B2 SP*	×	B2
 18.0	SP	÷
-19.01		×	QU
-19.02	QU	×
+19.01		×	[QU-\p{Pi}]
+19.02	[QU-\p{Pf}]	×
+19.11	[^$EastAsian]	×	QU
+19.12		×	QU ( [^$EastAsian] | eot )
+19.13	QU	×	[^$EastAsian]
+19.14	( sot | [^$EastAsian] ) QU	×
 20.01		÷	CB
 20.02	CB	÷
+20.1	(sot | BK | CR | LF | NL | SP | ZW | CB | GL) (HY | HH)	×	(AL | HL)
 21.01		×	BA
 21.02		×	HY
-21.03		×	NS
-21.04	BB	×
-21.1	HL (HY | BA)	×
+21.03		×	HH
+21.04		×	NS
+21.05	BB	×
+21.1	HL (HY | HH)	×	[^HL]
 21.2	SY	×	HL
-22.01	(AL | HL)	×	IN
-22.02	EX	×	IN
-22.03	(ID | EB | EM)	×	IN
-22.04	IN	×	IN
-22.05	NU	×	IN
+22.0		×	IN
 23.02	(AL | HL)	×	NU
 23.03	NU	×	(AL | HL)
 23.12	PR	×	(ID | EB | EM)
 23.13	(ID | EB | EM)	×	PO
 24.02	(PR | PO)	×	(AL | HL)
 24.03	(AL | HL)	×	(PR | PO)
-25.01	(PR | PO)	×	( OP | HY )? NU
-25.02	( OP | HY )	×	NU
-25.03	NU	×	(NU | SY | IS)
-25.04	NU (NU | SY | IS)*	×	(NU | SY | IS | CL | CP)
-25.05	NU (NU | SY | IS)* (CL | CP)?	×	(PO | PR)
+25.01	(PR | PO)	×	( OP | HY )? IS? NU
+25.02	( OP | HY )	×	IS? NU
+25.03	IS	×	NU
+25.04	NU	×	(NU | SY | IS)
+25.05	NU (NU | SY | IS)*	×	(NU | SY | IS | CL | CP)
+25.06	NU (NU | SY | IS)* (CL | CP)?	×	(PO | PR)
 26.01	JL	×	JL | JV | H2 | H3
 26.02	JV | H2	×	JV | JT
 26.03	JT | H3	×	JT
@@ -103,13 +113,18 @@ OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 27.02	JL | JV | JT | H2 | H3	×	PO
 27.03	PR	×	JL | JV | JT | H2 | H3
 28.0	(AL | HL)	×	(AL | HL)
+28.11	AP	×	(AK | ◌ | AS)
+28.12	(AK | ◌ | AS)	×	(VF | VI)
+28.13	(AK | ◌ | AS) VI	×	(AK | ◌)
+28.14	(AK | ◌ | AS)	×	(AK | ◌ | AS) VF
 29.0	IS	×	(AL | HL)
-30.01	(AL | HL | NU)	×	OP
-30.02	CP	×	(AL | HL | NU)
+30.01	(AL | HL | NU)	×	[OP-[\p{ea=F}\p{ea=W}\p{ea=H}]]
+30.02	[CP-[\p{ea=F}\p{ea=W}\p{ea=H}]]	×	(AL | HL | NU)
 30.11	^ (RI RI)* RI	×	RI
 30.12	[^RI] (RI RI)* RI	×	RI
 30.13	RI	÷	RI
-30.2	EB	×	EM
+30.21	EB	×	EM
+30.22	[\p{Extended_Pictographic}&\p{Cn}]	×	EM
 */
 
 // ----------------------------------------------------------------------
@@ -118,20 +133,20 @@ OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
 // No longer used.
 /*
 func rule_LB2(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
-	return uax.DoAccept(rec, PenaltyToSuppressBreak)
+	return uax.DoAccept(rec, lwPenalties(rec).ToSuppressBreak)
 }
 */
 
 // LB3 Always break at the end of text.
 func rule_LB3(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
-	return uax.DoAccept(rec, 0, PenaltyForMustBreak)
+	return uax.DoAccept(rec, 0, lwPenalties(rec).ForMustBreak)
 }
 
 func rule_05_NewLine(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
 	if c == BKClass || c == NLClass || c == LFClass {
 		rec.MatchLen++
-		return uax.DoAccept(rec, PenaltyForMustBreak)
+		return uax.DoAccept(rec, lwPenalties(rec).ForMustBreak)
 	} else if c == CRClass {
 		rec.MatchLen++
 		return rule_05_CRLF
@@ -142,23 +157,23 @@ func rule_05_NewLine(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 func rule_05_CRLF(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
 	if c == LFClass {
-		return uax.DoAccept(rec, PenaltyForMustBreak, PenaltyToSuppressBreak, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, lwPenalties(rec).ForMustBreak, lwPenalties(rec).ToSuppressBreak, lwPenalties(rec).ToSuppressBreak)
 	}
-	return uax.DoAccept(rec, 0, PenaltyForMustBreak, PenaltyToSuppressBreak)
+	return uax.DoAccept(rec, 0, lwPenalties(rec).ForMustBreak, lwPenalties(rec).ToSuppressBreak)
 }
 
 func rule_06_HardBreak(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
 	if c == BKClass || c == NLClass || c == LFClass || c == CRClass {
 		//rec.MatchLen++
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, lwPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
 
 // LB7 Do not break before spaces or zero width space.
 func rule_LB7(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
-	return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+	return uax.DoAccept(rec, 0, lwPenalties(rec).ToSuppressBreak)
 }
 
 // LB8 Break before any character following a zero-width space, even if
@@ -180,6 +195,8 @@ func finish_LB8(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 }
 
 // LB8a Do not break after a zero width joiner.
+// This rule is started for code-points with a shadow class of ZWJ, as ZWJ
+// is substituted by rules LB9 and LB10.
 //
 // ZWJ x
 func rule_LB8a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
@@ -207,12 +224,12 @@ func rule_LB12(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 // LB12a: Do not break before NBSP and related characters, except after
 // spaces and hyphens.
 //
-// [^SP BA HY] x GL
+// [^SP BA HY HH] x GL
 func rule_LB12(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	//c := UAX14Class(cpClass)
 	uax14 := rec.UserData.(*LineWrap)
 	last := uax14.lastClass
-	if last == SPClass || last == BAClass || last == HYClass {
+	if last == SPClass || last == BAClass || last == HYClass || last == HHClass {
 		return uax.DoAccept(rec, p(12))
 	}
 	return uax.DoAccept(rec, p(12), p(12))
@@ -220,7 +237,9 @@ func rule_LB12(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 
 // LB13: Do not break before ‘]’ or ‘!’ or ‘;’ or ‘/’, even after spaces.
 //
-// x (CL | CP | EX | IS | SY)
+// x (CL | CP | EX | SY)
+//
+// Class IS is handled by rules LB15c and LB15d.
 func rule_LB13(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAccept(rec, 0, p(13))
 }
@@ -242,26 +261,102 @@ func finish_LB14(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAccept(rec, 0, p(14))
 }
 
-// LB15: Do not break within ‘”[’, even with intervening spaces.
+// LB15a Do not break after an unresolved initial quotation mark, even after
+// spaces.
 //
-// QU SP* x OP
-func rule_LB15(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+// (sot | BK | CR | LF | NL | OP | QU | GL | SP | ZW) [\p{Pi}&QU] SP* x
+func rule_LB15a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if !unicode.Is(unicode.Pi, r) {
+		return uax.DoAbort(rec)
+	}
+	switch rec.UserData.(*LineWrap).lastClass {
+	case sot, BKClass, CRClass, LFClass, NLClass, OPClass, QUClass, GLClass, SPClass, ZWClass:
+		rec.MatchLen++
+		return finish_LB15a
+	}
+	return uax.DoAbort(rec)
+}
+
+// ... SP* x
+func finish_LB15a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if c == SPClass || isAbsorbed(rec, c) {
+		return finish_LB15a
+	}
+	return uax.DoAccept(rec, 0, p(15))
+}
+
+// LB15b Do not break before an unresolved final quotation mark, even after
+// spaces.
+//
+// x [\p{Pf}&QU] ( SP | GL | WJ | CL | QU | CP | EX | IS | SY | BK | CR | LF | NL | ZW | eot)
+//
+// Without intervening spaces, rule LB19 already prohibits a break before QU.
+// We therefore start this rule on spaces only.
+func rule_LB15b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	rec.MatchLen++
-	return finish_LB15
+	return step2_LB15b
 }
 
-// ... SP* x OP
-func finish_LB15(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+// SP ... [\p{Pf}&QU]
+func step2_LB15b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
-	if c == SPClass {
-		return finish_LB15
+	if c == QUClass && unicode.Is(unicode.Pf, r) {
+		rec.MatchLen++
+		return finish_LB15b
 	}
-	if c == OPClass {
-		return uax.DoAccept(rec, 0, p(15))
+	return uax.DoAbort(rec)
+}
+
+// SP x [\p{Pf}&QU] ... ( SP | GL | WJ | CL | QU | CP | EX | IS | SY | BK | CR | LF | NL | ZW | eot)
+func finish_LB15b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	switch UAX14Class(cpClass) {
+	case SPClass, GLClass, WJClass, CLClass, QUClass, CPClass, EXClass, ISClass, SYClass,
+		BKClass, CRClass, LFClass, NLClass, ZWClass, eot:
+		return uax.DoAccept(rec, 0, 0, p(15))
+	}
+	return uax.DoAbort(rec)
+}
+
+// LB15c Break before an IS that begins a number and follows a space.
+//
+// SP ÷ IS NU
+//
+// LB15d Otherwise, do not break before ‘;’, ‘,’, or ‘.’, even after spaces.
+//
+// x IS
+func rule_LB15c(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	return step2_LB15c
+}
+
+// SP ... IS NU
+func step2_LB15c(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if c == ISClass {
+		rec.MatchLen++
+		return finish_LB15c
 	}
 	return uax.DoAbort(rec)
 }
 
+// SP IS ... NU
+func finish_LB15c(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if c == NUClass {
+		return uax.DoAbort(rec) // leave the break opportunity to LB18
+	}
+	return uax.DoAccept(rec, 0, 0, p(15)) // LB15d: SP x IS
+}
+
+// x IS, if not preceded by a space (see LB15c)
+func rule_LB15d(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if rec.UserData.(*LineWrap).lastClass == SPClass {
+		return uax.DoAbort(rec)
+	}
+	return uax.DoAccept(rec, 0, p(15))
+}
+
 // LB16 Do not break between closing punctuation and a nonstarter (lb=NS),
 // even with intervening spaces.
 //
@@ -310,13 +405,66 @@ func rule_LB18(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAccept(rec, -p(18))
 }
 
-// LB19 Do not break before or after quotation marks.
+// LB19 Do not break before non-initial unresolved quotation marks, nor
+// after non-final unresolved quotation marks.
+//
+// x [QU-\p{Pi}]
+// [QU-\p{Pf}] x
+//
+// LB19a Unless surrounded by East Asian characters, do not break either
+// side of any unresolved quotation marks.
 //
-// x QU x
+// [^$EastAsian] x QU
+// x QU ( [^$EastAsian] | eot )
+// QU x [^$EastAsian]
+// ( sot | [^$EastAsian] ) QU x
+//
+// Between East Asian characters, the break opportunity before the quotation
+// mark is decided after having seen the next code-point. The match therefore
+// includes the preceding code-point, to hold back the segmenter.
 func rule_LB19(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if rec.UserData.(*LineWrap).lastEA {
+		if unicode.Is(unicode.Pi, r) {
+			rec.MatchLen += 2
+			return finish_LB19_Pi
+		} else if unicode.Is(unicode.Pf, r) {
+			rec.MatchLen += 2
+			return finish_LB19_Pf
+		}
+	}
 	return uax.DoAccept(rec, p(19), p(19))
 }
 
+// $EastAsian [QU&\p{Pi}] x ...
+func finish_LB19_Pi(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		rec.MatchLen++
+		return finish_LB19_Pi
+	}
+	pp := make([]int, rec.MatchLen+1)
+	pp[rec.MatchLen-1] = p(19)
+	if c == eot || !isEastAsian(r) {
+		pp[rec.MatchLen] = p(19)
+	}
+	return uax.DoAccept(rec, pp...)
+}
+
+// $EastAsian x [QU&\p{Pf}] ...
+func finish_LB19_Pf(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		rec.MatchLen++
+		return finish_LB19_Pf
+	}
+	pp := make([]int, rec.MatchLen+1)
+	pp[rec.MatchLen] = p(19)
+	if c == eot || !isEastAsian(r) {
+		pp[rec.MatchLen-1] = p(19)
+	}
+	return uax.DoAccept(rec, pp...)
+}
+
 // LB20 Break before and after unresolved CB.
 //
 // + CB +
@@ -328,10 +476,37 @@ func rule_LB20(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAccept(rec, -p(20), -p(20))
 }
 
+// LB20a Do not break after a word-initial hyphen.
+//
+// (sot | BK | CR | LF | NL | SP | ZW | CB | GL) (HY | HH) x (AL | HL)
+func rule_LB20a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if c := UAX14Class(cpClass); c != HYClass && c != HHClass {
+		return uax.DoAbort(rec)
+	}
+	switch rec.UserData.(*LineWrap).lastClass {
+	case sot, BKClass, CRClass, LFClass, NLClass, SPClass, ZWClass, CBClass, GLClass:
+		rec.MatchLen++
+		return finish_LB20a
+	}
+	return uax.DoAbort(rec)
+}
+
+// ... x (AL | HL)
+func finish_LB20a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		return finish_LB20a
+	}
+	if c == ALClass || c == HLClass {
+		return uax.DoAccept(rec, 0, p(20))
+	}
+	return uax.DoAbort(rec)
+}
+
 // LB21 Do not break before hyphen-minus, other hyphens, fixed-width spaces,
 // small kana, and other non-starters, or after acute accents.
 //
-// x (BA | HY | NS)
+// x (BA | HY | HH | NS)
 func rule_LB21(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAccept(rec, 0, p(21))
 }
@@ -343,21 +518,37 @@ func rule_LB21x(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 
 // LB21a Don't break after Hebrew + Hyphen.
 //
-// HL (HY | BA) x
+// HL (HY | HH) x [^HL]
 func rule_LB21a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	rec.MatchLen++
-	return finish_LB21a
+	return step2_LB21a
 }
 
-// .. (HY | BA) x
-func finish_LB21a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+// ... (HY | HH)
+func step2_LB21a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
-	if c == HYClass || c == BAClass {
-		return uax.DoAccept(rec, p(21))
+	if isAbsorbed(rec, c) {
+		return step2_LB21a
+	}
+	if c == HYClass || c == HHClass {
+		rec.MatchLen++
+		return finish_LB21a
 	}
 	return uax.DoAbort(rec)
 }
 
+// ... x [^HL]
+func finish_LB21a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		return finish_LB21a
+	}
+	if c == HLClass || c == CBClass {
+		return uax.DoAbort(rec)
+	}
+	return uax.DoAccept(rec, 0, p(21))
+}
+
 //  LB21b Don’t break between Solidus and Hebrew letters.
 //
 // SY x HL
@@ -375,26 +566,11 @@ func finish_LB21b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAbort(rec)
 }
 
-// LB22 Do not break between two ellipses, or between letters, numbers or
-// exclamations and ellipsis.
+// LB22 Do not break before ellipses.
 //
-// (AL | HL) x IN
-// EX x IN
-// (ID | EB | EM) x IN
-// IN x IN
-// NU x IN
+// x IN
 func rule_LB22(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
-	rec.MatchLen++
-	return finish_LB22
-}
-
-// ... x IN
-func finish_LB22(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
-	c := UAX14Class(cpClass)
-	if c == INClass {
-		return uax.DoAccept(rec, 0, p(22))
-	}
-	return uax.DoAbort(rec)
+	return uax.DoAccept(rec, 0, p(22))
 }
 
 // LB23 Do not break between digits and letters.
@@ -498,7 +674,7 @@ func finish_LB24a_2(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 // LB25 Do not break between the following regular expression relevant to
 // numbers:
 //
-// ( PR | PO) ? ( OP | HY ) ? NU (NU | SY | IS) * (CL | CP) ? ( PR | PO) ?
+// ( PR | PO) ? ( OP | HY ) ? IS ? NU (NU | SY | IS) * (CL | CP) ? ( PR | PO) ?
 //
 // Examples: Examples: $(12.35)    2,1234    (12)¢    12.54¢.
 // Example pairs: ‘$9’, ‘$[’, ‘$-’, ‘-9’, ‘/9’, ‘99’, ‘,9’, ‘9%’, ‘]%’.
@@ -512,6 +688,9 @@ func step2_LB25(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	if c == OPClass || c == HYClass {
 		rec.MatchLen++
 		return step3_LB25
+	} else if c == ISClass {
+		rec.MatchLen++
+		return step3a_LB25
 	} else if c == NUClass {
 		rec.MatchLen++
 		return step4_LB25
@@ -520,6 +699,18 @@ func step2_LB25(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 }
 
 func step3_LB25(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if c == ISClass {
+		rec.MatchLen++
+		return step3a_LB25
+	} else if c == NUClass {
+		rec.MatchLen++
+		return step4_LB25
+	}
+	return uax.DoAbort(rec)
+}
+
+func step3a_LB25(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
 	if c == NUClass {
 		rec.MatchLen++
@@ -655,6 +846,116 @@ func finish_LB28(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAbort(rec)
 }
 
+// LB28a Do not break inside the orthographic syllables of Brahmic scripts.
+//
+// AP x (AK | ◌ | AS)
+func rule_LB28a_1(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	return finish_LB28a_1
+}
+
+// ... x (AK | ◌ | AS)
+func finish_LB28a_1(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		return finish_LB28a_1
+	}
+	if isAksara(r, c, true) {
+		return uax.DoAccept(rec, 0, p(28))
+	}
+	return uax.DoAbort(rec)
+}
+
+// (AK | ◌ | AS) x (VF | VI)
+// (AK | ◌ | AS) VI x (AK | ◌)
+// (AK | ◌ | AS) x (AK | ◌ | AS) VF
+func rule_LB28a_2(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	return finish_LB28a_2
+}
+
+// ... x (VF | VI)
+// ... VI x (AK | ◌)
+// ... x (AK | ◌ | AS) VF
+//
+// Combining marks may follow every part of the syllable (see LB9), which
+// makes the position of the second part variable. We remember it as the
+// MatchLen after the second part in rec.Expect, which is otherwise unused
+// by UAX#14.
+func finish_LB28a_2(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		rec.MatchLen++
+		return finish_LB28a_2
+	}
+	if c == VFClass {
+		return uax.DoAccept(rec, 0, p(28))
+	} else if c == VIClass {
+		rec.MatchLen++
+		rec.Expect = rec.MatchLen
+		return finish_LB28a_3
+	} else if isAksara(r, c, true) {
+		rec.MatchLen++
+		rec.Expect = rec.MatchLen
+		return finish_LB28a_4
+	}
+	return uax.DoAbort(rec)
+}
+
+// ... VI x (AK | ◌)
+func finish_LB28a_3(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		rec.MatchLen++
+		return finish_LB28a_3
+	}
+	pp := penaltiesBeforeSecond(rec)
+	if isAksara(r, c, false) {
+		pp[1] = p(28)
+	}
+	return uax.DoAccept(rec, pp...) // (AK | ◌ | AS) x VI
+}
+
+// ... x (AK | ◌ | AS) VF
+func finish_LB28a_4(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := UAX14Class(cpClass)
+	if isAbsorbed(rec, c) {
+		rec.MatchLen++
+		return finish_LB28a_4
+	}
+	if c == VFClass {
+		pp := penaltiesBeforeSecond(rec)
+		pp[1] = p(28)
+		return uax.DoAccept(rec, pp...)
+	}
+	return uax.DoAbort(rec)
+}
+
+// penaltiesBeforeSecond creates a slice of penalties for LB28a, with a
+// non-break penalty before the second part of the syllable, as recorded
+// in rec.Expect.
+func penaltiesBeforeSecond(rec *uax.Recognizer) []int {
+	i := rec.MatchLen - rec.Expect + 2
+	pp := make([]int, i+1)
+	pp[i] = p(28)
+	return pp
+}
+
+// dottedCircle is U+25CC, which is used as a placeholder for aksara bases.
+const dottedCircle = '\u25CC'
+
+// isAksara is true for (AK | ◌ | AS), or for (AK | ◌) if withAS is false.
+func isAksara(r rune, c UAX14Class, withAS bool) bool {
+	return c == AKClass || r == dottedCircle || (withAS && c == ASClass)
+}
+
+// isAbsorbed is true if the current rune is a combining mark which has been
+// absorbed by the preceding rune of class c (see LB9).
+func isAbsorbed(rec *uax.Recognizer, c UAX14Class) bool {
+	uax14 := rec.UserData.(*LineWrap)
+	return uax14.substituted && uax14.lastClass == c
+}
+
 // LB29 Do not break between numeric punctuation and alphabetics (“e.g.”).
 //
 // IS x (AL | HL)
@@ -677,15 +978,19 @@ func finish_LB29(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 //
 // The purpose of this rule is to prevent breaks in common cases where a
 // part of a word appears between delimiters—for example, in “person(s)”.
+// East Asian parentheses are excluded, as they are not used this way.
 //
-// (AL | HL | NU) x OP
+// (AL | HL | NU) x [OP-[\p{ea=F}\p{ea=W}\p{ea=H}]]
 func rule_LB30_1(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	rec.MatchLen++
 	return finish_LB30_1
 }
 
-// CP x (AL | HL | NU)
+// [CP-[\p{ea=F}\p{ea=W}\p{ea=H}]] x (AL | HL | NU)
 func rule_LB30_2(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	if isEastAsian(r) {
+		return uax.DoAbort(rec)
+	}
 	rec.MatchLen++
 	return finish_LB30_2
 }
@@ -693,7 +998,7 @@ func rule_LB30_2(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 // ... x OP
 func finish_LB30_1(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
-	if c == OPClass {
+	if c == OPClass && !isEastAsian(r) {
 		return uax.DoAccept(rec, 0, p(30))
 	}
 	return uax.DoAbort(rec)
@@ -736,9 +1041,12 @@ func finish_LB30a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	return uax.DoAbort(rec)
 }
 
-// LB30b Do not break between an emoji base and an emoji modifier.
+// LB30b Do not break between an emoji base (or potential emoji) and an
+// emoji modifier. Unassigned code-points of Extended_Pictographic are
+// started with this rule as well (see StartRulesFor).
 //
 // EB x EM
+// [\p{Extended_Pictographic}&\p{Cn}] x EM
 func rule_LB30b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	rec.MatchLen++
 	return finish_LB30b
@@ -747,7 +1055,9 @@ func rule_LB30b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 // ... x EM
 func finish_LB30b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX14Class(cpClass)
-
+	if isAbsorbed(rec, c) {
+		return finish_LB30b
+	}
 	if c == EMClass {
 		return uax.DoAccept(rec, 0, p(30))
 	}
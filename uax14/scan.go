@@ -0,0 +1,22 @@
+package uax14
+
+import (
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/segment"
+)
+
+var scanLineBreakOpportunities = segment.SplitFunc(func() uax.UnicodeBreaker {
+	return NewLineWrap()
+})
+
+// ScanLineBreakOpportunities is a split function for a bufio.Scanner that returns
+// the text between two UAX#14 line break opportunities as a token. Trailing
+// spaces and mandatory breaks are part of the token before the break.
+//
+//   scanner := bufio.NewScanner(r)
+//   scanner.Split(uax14.ScanLineBreakOpportunities)
+//
+// See segment.SplitFunc.
+func ScanLineBreakOpportunities(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scanLineBreakOpportunities(data, atEOF)
+}
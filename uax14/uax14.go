@@ -31,11 +31,26 @@ not done beforehand, as it consumes quite some memory, and using UAX#14
 is not mandatory. SetupClasses() is called automatically, however,
 if clients call NewLineWrap().
 
-Status
+Tailoring
+
+Line breaking may be tailored following the CSS properties `line-break` and
+`word-break` (see CSSLineBreak and CSSWordBreak):
+
+  breaker := uax14.NewLineWrap(uax14.CSSLineBreak(uax14.LineBreakNormal))
+
+For Japanese text, kinsoku rules may be set (see KinsokuShori). Violations
+of these rules are penalized, but not prohibited:
 
-The current implementation passes all tests from the UAX#14 test file, except 3:
+  breaker := uax14.NewLineWrap(uax14.KinsokuShori(uax14.StandardKinsoku()))
+
+Runs of Thai, Lao, Khmer and Myanmar text are broken at word boundaries,
+if a dictionary has been set (see SetDictionary).
+
+Status
 
-    uax14_test.go:65: 3 TEST CASES OUT of 7001 FAILED
+The current implementation follows the rules of the Unicode version given by
+UnicodeVersion, and the class tables have been generated from LineBreak.txt of
+the same version. It passes all 19338 tests of LineBreakTest.txt of this version.
 
 ______________________________________________________________________
 
@@ -63,8 +78,15 @@ import (
 
 	"github.com/npillmayer/schuko/tracing"
 	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/dictionary"
+	"github.com/npillmayer/uax/emoji"
+	"github.com/npillmayer/uax/internal/dictrun"
+	"golang.org/x/text/width"
 )
 
+// UnicodeVersion is the UAX#14 version of the line breaking classes and rules.
+const UnicodeVersion = "17.0.0"
+
 const (
 	sot       UAX14Class = 1000 // pseudo class
 	eot       UAX14Class = 1001 // pseudo class
@@ -78,16 +100,11 @@ func tracer() tracing.Trace {
 
 // ClassForRune gets the line breaking/wrap class for a Unicode code-point
 func ClassForRune(r rune) UAX14Class {
-	if r == rune(0) {
+	if r == uax.EOT {
 		return eot
 	}
-	for lbc := UAX14Class(0); lbc <= ZWJClass; lbc++ {
-		urange := rangeFromUAX14Class[lbc]
-		if urange == nil {
-			tracer().Errorf("-- no range for class %s\n", lbc)
-		} else if unicode.Is(urange, r) {
-			return lbc
-		}
+	if c := uax14ClassTable.Lookup(r); c >= 0 {
+		return UAX14Class(c)
 	}
 	return XXClass
 }
@@ -110,10 +127,17 @@ type LineWrap struct {
 	longestMatch int   // longest active match of a rule
 	penalties    []int // returned to the segmenter: penalties to insert
 	rules        map[UAX14Class][]uax.NfaStateFn
-	lastClass    UAX14Class // we have to remember the last code-point class
-	blockedRI    bool       // are rules for Regional_Indicator currently blocked?
-	substituted  bool       // has the code-point class been substituted?
-	shadow       UAX14Class // class before substitution
+	lastClass    UAX14Class   // we have to remember the last code-point class
+	lastEA       bool         // was the last code-point East Asian? (see LB19a)
+	blockedRI    bool         // are rules for Regional_Indicator currently blocked?
+	substituted  bool         // has the code-point class been substituted?
+	shadow       UAX14Class   // class before substitution
+	complex      bool         // is the code-point of class SA?
+	dictRun      *dictrun.Run // run of SA code-points, if segmented by a dictionary
+	lineBreak    LineBreakMode
+	wordBreak    WordBreakMode
+	kinsoku      *kinsoku // kinsoku rules, if any
+	profile      PenaltyProfile
 }
 
 // NewLineWrap creates a new UAX#14 line breaker.
@@ -125,64 +149,112 @@ type LineWrap struct {
 //   segmenter.Init(...)
 //   for segmenter.Next() ...
 //
-func NewLineWrap() *LineWrap {
-	uax14 := &LineWrap{}
+// Options tailor the line breaker according to the CSS properties `line-break`
+// and `word-break`:
+//
+//   linewrap := NewLineWrap(CSSLineBreak(LineBreakLoose), CSSWordBreak(WordBreakKeepAll))
+//
+// Option UsePenalties replaces the default penalties (see DefaultPenalties).
+//
+func NewLineWrap(opts ...Option) *LineWrap {
+	uax14 := &LineWrap{profile: DefaultPenalties()}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(uax14)
+		}
+	}
 	uax14.publisher = uax.NewRunePublisher()
 	uax14.rules = map[UAX14Class][]uax.NfaStateFn{
 		//sot:      {rule_LB2},
-		eot:      {rule_LB3},
-		NLClass:  {rule_05_NewLine, rule_06_HardBreak},
-		LFClass:  {rule_05_NewLine, rule_06_HardBreak},
-		BKClass:  {rule_05_NewLine, rule_06_HardBreak},
-		CRClass:  {rule_05_NewLine, rule_06_HardBreak},
-		SPClass:  {rule_LB7, rule_LB18},
-		ZWClass:  {rule_LB7, rule_LB8},
-		WJClass:  {rule_LB11},
-		GLClass:  {rule_LB12},
-		CLClass:  {rule_LB13, rule_LB16},
-		CPClass:  {rule_LB13, rule_LB16, rule_LB30_2},
-		EXClass:  {rule_LB13, rule_LB22},
-		ISClass:  {rule_LB13, rule_LB29},
-		SYClass:  {rule_LB13, rule_LB21b},
-		OPClass:  {rule_LB14, step2_LB25},
-		QUClass:  {rule_LB15, rule_LB19},
-		B2Class:  {rule_LB17},
-		BAClass:  {rule_LB21},
-		CBClass:  {rule_LB20},
-		HYClass:  {rule_LB21, step2_LB25},
-		NSClass:  {rule_LB21},
-		BBClass:  {rule_LB21x},
-		ALClass:  {rule_LB22, rule_LB23_1, rule_LB24_2, rule_LB28, rule_LB30_1},
-		HLClass:  {rule_LB21a, rule_LB22, rule_LB23_1, rule_LB24_2, rule_LB28, rule_LB30_1},
-		IDClass:  {rule_LB22, rule_LB23a_2},
-		EBClass:  {rule_LB22, rule_LB23a_2, rule_LB30b},
-		EMClass:  {rule_LB22, rule_LB23a_2},
-		INClass:  {rule_LB22},
-		NUClass:  {rule_LB22, rule_LB23_2, step3_LB25, rule_LB30_1},
-		RIClass:  {rule_LB30a},
-		PRClass:  {rule_LB23a_1, rule_LB24_1, rule_LB25, rule_LB27_2},
-		POClass:  {rule_LB24_1, rule_LB25},
-		JLClass:  {rule_LB26_1, rule_LB27},
-		JVClass:  {rule_LB26_2, rule_LB27},
-		H2Class:  {rule_LB26_2, rule_LB27},
-		JTClass:  {rule_LB26_3, rule_LB27},
-		H3Class:  {rule_LB26_3, rule_LB27},
-		ZWJClass: {rule_LB8a},
+		eot:     {rule_LB3},
+		NLClass: {rule_05_NewLine, rule_06_HardBreak},
+		LFClass: {rule_05_NewLine, rule_06_HardBreak},
+		BKClass: {rule_05_NewLine, rule_06_HardBreak},
+		CRClass: {rule_05_NewLine, rule_06_HardBreak},
+		SPClass: {rule_LB7, rule_LB15b, rule_LB15c, rule_LB18},
+		ZWClass: {rule_LB7, rule_LB8},
+		WJClass: {rule_LB11},
+		GLClass: {rule_LB12},
+		CLClass: {rule_LB13, rule_LB16},
+		CPClass: {rule_LB13, rule_LB16, rule_LB30_2},
+		EXClass: {rule_LB13},
+		ISClass: {rule_LB15d, step3_LB25, rule_LB29},
+		SYClass: {rule_LB13, rule_LB21b},
+		OPClass: {rule_LB14, step2_LB25},
+		QUClass: {rule_LB15a, rule_LB19},
+		B2Class: {rule_LB17},
+		BAClass: {rule_LB21},
+		CBClass: {rule_LB20},
+		HYClass: {rule_LB20a, rule_LB21, step2_LB25},
+		HHClass: {rule_LB20a, rule_LB21},
+		NSClass: {rule_LB21},
+		BBClass: {rule_LB21x},
+		ALClass: {rule_LB23_1, rule_LB24_2, rule_LB28, rule_LB30_1},
+		HLClass: {rule_LB21a, rule_LB23_1, rule_LB24_2, rule_LB28, rule_LB30_1},
+		IDClass: {rule_LB23a_2},
+		EBClass: {rule_LB23a_2, rule_LB30b},
+		EMClass: {rule_LB23a_2},
+		INClass: {rule_LB22},
+		NUClass: {rule_LB23_2, step3_LB25, rule_LB30_1},
+		RIClass: {rule_LB30a},
+		APClass: {rule_LB28a_1},
+		AKClass: {rule_LB28a_2},
+		ASClass: {rule_LB28a_2},
+		PRClass: {rule_LB23a_1, rule_LB24_1, rule_LB25, rule_LB27_2},
+		POClass: {rule_LB24_1, rule_LB25},
+		JLClass: {rule_LB26_1, rule_LB27},
+		JVClass: {rule_LB26_2, rule_LB27},
+		H2Class: {rule_LB26_2, rule_LB27},
+		JTClass: {rule_LB26_3, rule_LB27},
+		H3Class: {rule_LB26_3, rule_LB27},
 	}
 	if rangeFromUAX14Class == nil {
 		tracer().Infof("UAX#14 classes not yet initialized -> initializing")
 	}
 	SetupClasses()
+	emoji.SetupEmojisClasses() // needed for rule LB30b
 	uax14.lastClass = sot
 	return uax14
 }
 
+// SetDictionary sets a word list to find line break opportunities within runs of
+// class SA (Thai, Lao, Khmer, Myanmar, etc.). UAX#14 leaves this to a tailoring
+// of rule LB1. Without a word list, runs of SA code-points are never broken.
+//
+// Runs will be segmented by maximal matching (see dictionary.WordList.Segment)
+// and break opportunities with the default penalty of the LineWrap's penalty
+// profile will be placed between words.
+// Setting a nil word list switches off the tailoring.
+func (uax14 *LineWrap) SetDictionary(words *dictionary.WordList) {
+	if words == nil {
+		uax14.dictRun = nil
+		return
+	}
+	uax14.dictRun = dictrun.New(words)
+}
+
+// SetPenaltyAggregator sets the function to aggregate penalties of rules
+// firing at the same position. The default is uax.AddPenalties.
+func (uax14 *LineWrap) SetPenaltyAggregator(pa uax.PenaltyAggregator) {
+	uax14.publisher.SetPenaltyAggregator(pa)
+}
+
+// SetRuleObserver sets a callback which is informed about rules being started,
+// accepting or aborting. Setting nil switches reporting off.
+//
+// (Interface uax.RuleObservable)
+func (uax14 *LineWrap) SetRuleObserver(obs uax.RuleObserver) {
+	uax14.publisher.SetRuleObserver(obs)
+}
+
 // CodePointClassFor returns the UAX#14 code-point class for a rune (= code-point).
 //
 // Interface unicode.UnicodeBreaker
 func (uax14 *LineWrap) CodePointClassFor(r rune) int {
 	c := ClassForRune(r)
+	uax14.complex = (c == SAClass)
 	c = resolveSomeClasses(r, c)
+	c = uax14.tailorClass(r, c)
 	cnew, shadow := substitueSomeClasses(c, uax14.lastClass)
 	uax14.substituted = (c != cnew)
 	uax14.shadow = shadow
@@ -199,28 +271,30 @@ func (uax14 *LineWrap) StartRulesFor(r rune, cpClass int) {
 		if rules := uax14.rules[c]; len(rules) > 0 {
 			tracer().P("class", c).Debugf("starting %d rule(s) for class %s", len(rules), c)
 			for _, rule := range rules {
-				rec := uax.NewPooledRecognizer(cpClass, rule)
-				rec.UserData = uax14
-				uax14.publisher.SubscribeMe(rec)
+				uax14.startRule(cpClass, rule)
 			}
 		} else {
 			tracer().P("class", c).Debugf("starting no rule")
 		}
-		/*
-			if uax14.shadow == ZWJClass {
-				if rules := uax14.rules[uax14.shadow]; len(rules) > 0 {
-					TC.P("class", c).Debugf("starting %d rule(s) for shadow class ZWJ", len(rules))
-					for _, rule := range rules {
-						rec := uax.NewPooledRecognizer(cpClass, rule)
-						rec.UserData = uax14
-						uax14.publisher.SubscribeMe(rec)
-					}
-				}
-			}
-		*/
+		if uax14.shadow == ZWJClass { // ZWJ has been substituted by LB9 or LB10
+			tracer().P("class", c).Debugf("starting rule for shadow class ZWJ")
+			uax14.startRule(cpClass, rule_LB8a)
+		}
+		if r == dottedCircle { // ◌ is of class AL, but takes part in LB28a
+			uax14.startRule(cpClass, rule_LB28a_2)
+		}
+		if c != EBClass && isUnassignedPictographic(r) {
+			uax14.startRule(cpClass, rule_LB30b)
+		}
 	}
 }
 
+func (uax14 *LineWrap) startRule(cpClass int, rule uax.NfaStateFn) {
+	rec := uax.NewPooledRecognizer(cpClass, rule)
+	rec.UserData = uax14
+	uax14.publisher.SubscribeMe(rec)
+}
+
 // LB1 Assign a line breaking class to each code point of the input.
 // Resolve AI, CB, CJ, SA, SG, and XX into other line breaking classes
 // depending on criteria outside the scope of this algorithm.
@@ -234,6 +308,11 @@ func (uax14 *LineWrap) StartRulesFor(r rune, cpClass int) {
 //   AL         SA          Any except Mn and Mc
 //   NS         CJ          Any
 //
+// Clients may set a dictionary for SA (see SetDictionary), which will place
+// break opportunities between the words of runs of SA code-points.
+// The resolution of CJ depends on the CSS `line-break` tailoring and is done
+// in tailorClass.
+//
 func resolveSomeClasses(r rune, c UAX14Class) UAX14Class {
 	if c == AIClass || c == SGClass || c == XXClass {
 		return ALClass
@@ -242,8 +321,6 @@ func resolveSomeClasses(r rune, c UAX14Class) UAX14Class {
 			return CMClass
 		}
 		return ALClass
-	} else if c == CJClass {
-		return NSClass
 	}
 	return c
 }
@@ -322,8 +399,6 @@ func (uax14 *LineWrap) ProceedWithRune(r rune, cpClass int) {
 			x = make([]int, 2)
 			x[1] = noBreak
 		}
-	} else {
-		x = setPenalty1(x, DefaultPenalty)
 	}
 	for i, p := range x { // positive penalties get lifted +1000
 		if p > DefaultPenalty {
@@ -331,18 +406,38 @@ func (uax14 *LineWrap) ProceedWithRune(r rune, cpClass int) {
 			x[i] = p
 		}
 	}
+	x = setPenalty1(x, uax14.profile.Default) // no-op if x[1] is set already, e.g., for rule 09
 	//fmt.Printf("=> x = %v\n", x)
+	if uax14.lineBreak == LineBreakAnywhere {
+		x = uax14.breakAnywhere(c, x)
+	}
+	if uax14.kinsoku != nil {
+		x = uax14.kinsoku.apply(r, c, x)
+	}
+	if uax14.dictRun != nil && uax14.lineBreak != LineBreakAnywhere { // tailoring of LB1 for class SA
+		if uax14.complex {
+			uax14.dictRun.Append(r)
+			uax14.dictRun.Record(x, true)
+		} else if uax14.dictRun.Len() > 0 {
+			x = uax14.dictRun.Close(x, uax14.profile.Default, uax14.profile.ToSuppressBreak)
+		}
+	}
 	uax14.penalties = x
 	if c == eot { // start all over again
 		c = sot
 	}
+	if !uax14.substituted || uax14.lastClass != c { // combining marks keep it (LB9)
+		uax14.lastEA = c != sot && uax14.shadow != CMClass && uax14.shadow != ZWJClass &&
+			isEastAsian(r)
+	}
 	uax14.lastClass = c
 }
 
 // LongestActiveMatch is part of interface unicode.UnicodeBreaker
 func (uax14 *LineWrap) LongestActiveMatch() int {
 	// We return a value of at least 1, as explained above.
-	return max(1, uax14.longestMatch)
+	// A run of SA code-points is active until a dictionary has segmented it.
+	return max(max(1, uax14.longestMatch), uax14.dictRun.Len())
 }
 
 // Penalties gets all active penalties for all active recognizers combined.
@@ -364,13 +459,53 @@ func (uax14 *LineWrap) unblock() {
 	uax14.blockedRI = false
 }
 
-// Penalties (suppress break and mandatory break).
-var (
+// Default penalties (suppress break, mandatory break and break opportunity).
+//
+// Penalties of rules are derived from the rule numbers and kept apart from
+// DefaultPenalty while applying the rules; changing DefaultPenalty will break
+// this. Clients wanting another penalty for break opportunities set it in a
+// PenaltyProfile instead.
+const (
 	PenaltyToSuppressBreak = 10000  // Suppress break: ×
 	PenaltyForMustBreak    = -19000 // Break: !
 	DefaultPenalty         = 1      // Rule LB31: ÷    fragile, do not change!
 )
 
+// PenaltyProfile is a set of penalties for a LineWrap to emit. A profile is
+// handed to a line breaker at construction time (see UsePenalties), thus
+// line breakers with different profiles may be used side by side.
+// Penalties of the rules in between are derived from the rule numbers and
+// are not part of a profile. Neither is the penalty for violating kinsoku
+// rules, which is part of the rules (see Kinsoku).
+type PenaltyProfile struct {
+	ToSuppressBreak int // suppress break (×)
+	ForMustBreak    int // mandatory break (!)
+	Default         int // rule LB31: break everywhere else (÷)
+}
+
+// DefaultPenalties returns the penalty profile line breakers use if clients
+// do not provide one.
+func DefaultPenalties() PenaltyProfile {
+	return PenaltyProfile{
+		ToSuppressBreak: PenaltyToSuppressBreak,
+		ForMustBreak:    PenaltyForMustBreak,
+		Default:         DefaultPenalty,
+	}
+}
+
+// UsePenalties sets the penalty profile for a LineWrap.
+func UsePenalties(profile PenaltyProfile) Option {
+	return func(lw *LineWrap) {
+		lw.profile = profile
+	}
+}
+
+// lwPenalties returns the penalty profile of the line breaker a recognizer
+// has been started by.
+func lwPenalties(rec *uax.Recognizer) *PenaltyProfile {
+	return &rec.UserData.(*LineWrap).profile
+}
+
 // --- Helpers ---------------------------------------------------------------
 
 // This is a small function to return a penalty value for a rule.
@@ -405,6 +540,27 @@ func max(a, b int) int {
 	return b
 }
 
+// isEastAsian is true for code-points of East_Asian_Width F, W or H.
+// Rule LB30 excludes East Asian parentheses, rule LB19a allows breaks
+// around quotation marks between East Asian characters.
+func isEastAsian(r rune) bool {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianFullwidth, width.EastAsianWide, width.EastAsianHalfwidth:
+		return true
+	}
+	return false
+}
+
+// isUnassignedPictographic is true for code-points which are reserved for
+// future emojis: [\p{Extended_Pictographic}&\p{Cn}]
+func isUnassignedPictographic(r rune) bool {
+	if !unicode.Is(emoji.Extended_Pictographic, r) {
+		return false
+	}
+	return !unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S,
+		unicode.Z, unicode.Cc, unicode.Cf, unicode.Co, unicode.Cs)
+}
+
 func setPenalty1(P []int, p int) []int {
 	if len(P) == 0 {
 		P = append(P, 0)
@@ -1,11 +1,15 @@
 package uax14_test
 
 import (
+	"bufio"
+	"fmt"
+	"os"
 	"strings"
 	"testing"
 
 	"github.com/npillmayer/schuko/tracing"
 	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/dictionary"
 	"github.com/npillmayer/uax/internal/ucdparse"
 	"github.com/npillmayer/uax/segment"
 	"github.com/npillmayer/uax/uax14"
@@ -29,7 +33,32 @@ func TestSimpleLineWrap(t *testing.T) {
 	}
 }
 
-func TestWordBreakTestFile(t *testing.T) {
+func ExampleScanLineBreakOpportunities() {
+	scanner := bufio.NewScanner(strings.NewReader("Hello World, how are you?"))
+	scanner.Split(uax14.ScanLineBreakOpportunities)
+	for scanner.Scan() {
+		fmt.Printf("'%s'\n", scanner.Text())
+	}
+	// Output: 'Hello '
+	// 'World, '
+	// 'how '
+	// 'are '
+	// 'you?'
+}
+
+func TestUnicodeVersion(t *testing.T) {
+	f, err := os.Open("./LineBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	header, _ := bufio.NewReader(f).ReadString('\n')
+	if expected := "# LineBreakTest-" + uax14.UnicodeVersion + ".txt"; strings.TrimSpace(header) != expected {
+		t.Errorf("expected test file %q for UnicodeVersion, have %q", expected, strings.TrimSpace(header))
+	}
+}
+
+func TestLineBreakTestFile(t *testing.T) {
 	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
 	defer teardown()
 	tracer := tracing.Select("uax.segment")
@@ -39,7 +68,7 @@ func TestWordBreakTestFile(t *testing.T) {
 	tf := ucdparse.OpenTestFile("./LineBreakTest.txt", t)
 	defer tf.Close()
 	//failcnt, i, from, to := 0, 0, 6263, 7000
-	failcnt, i, from, to := 0, 0, 0, 7000
+	failcnt, i, from, to := 0, 0, 0, 20000
 	for tf.Scan() {
 		i++
 		if i >= from {
@@ -59,7 +88,7 @@ func TestWordBreakTestFile(t *testing.T) {
 	if err := tf.Err(); err != nil {
 		t.Errorf("reading input: %s", err)
 	}
-	if failcnt > 10 {
+	if failcnt > 0 {
 		t.Errorf("%d TEST CASES OUT of %d FAILED", failcnt, i-from+1)
 	} else {
 		t.Logf("%d TEST CASES OUT of %d FAILED", failcnt, i-from+1)
@@ -82,3 +111,181 @@ func executeSingleTest(t *testing.T, seg *segment.Segmenter, tno int, in string,
 	}
 	return ok
 }
+
+func TestLineWrapDictionary(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	text := "ฉันรักภาษาไทยมาก ABC"
+	linewrap := uax14.NewLineWrap()
+	segmenter := segment.NewSegmenter(linewrap)
+	segmenter.InitFromString(text)
+	n := 0
+	for segmenter.Next() {
+		n++
+	}
+	if n != 2 { // break after the space and at the end of text
+		t.Errorf("expected SA run not to be broken without a dictionary, have %d segments", n)
+	}
+	linewrap.SetDictionary(dictionary.NewWordList("ฉัน", "รัก", "ภาษา", "ไทย", "มาก"))
+	segmenter = segment.NewSegmenter(linewrap)
+	segmenter.InitFromString(text)
+	var fragments []string
+	for segmenter.Next() {
+		fragments = append(fragments, segmenter.Text())
+	}
+	expected := []string{"ฉัน", "รัก", "ภาษา", "ไทย", "มาก ", "ABC"}
+	if strings.Join(fragments, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected line break opportunities %q, have %q", expected, fragments)
+	}
+}
+
+func TestCSSTailoring(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	strict := uax14.CSSLineBreak(uax14.LineBreakStrict)
+	normal := uax14.CSSLineBreak(uax14.LineBreakNormal)
+	loose := uax14.CSSLineBreak(uax14.LineBreakLoose)
+	anywhere := uax14.CSSLineBreak(uax14.LineBreakAnywhere)
+	keepAll := uax14.CSSWordBreak(uax14.WordBreakKeepAll)
+	breakAll := uax14.CSSWordBreak(uax14.WordBreakBreakAll)
+	for i, test := range []struct {
+		opts     []uax14.Option
+		text     string
+		segments string
+	}{
+		{nil, "ちょっと待って。", "ちょっ|と|待っ|て。"},
+		{[]uax14.Option{strict}, "ちょっと待って。", "ちょっ|と|待っ|て。"},
+		{[]uax14.Option{normal}, "ちょっと待って。", "ち|ょ|っ|と|待|っ|て。"},
+		{[]uax14.Option{keepAll}, "ちょっと待って。", "ちょっと待って。"},
+		{[]uax14.Option{normal}, "人々・ＡＢ％", "人々・|Ａ|Ｂ％"},
+		{[]uax14.Option{loose}, "人々・ＡＢ％", "人|々|・|Ａ|Ｂ|％"},
+		{[]uax14.Option{anywhere}, "Hello, wörld!\r\nx", "H|e|l|l|o|,| |w|ö|r|l|d|!|\r\n|x"},
+		{[]uax14.Option{anywhere, keepAll}, "e\u0301e\u0301 x", "e\u0301|e\u0301| |x"},
+		{[]uax14.Option{breakAll}, "Hello, wörld 42", "H|e|l|l|o, |w|ö|r|l|d |4|2"},
+		{nil, "한국어 텍스트", "한|국|어 |텍|스|트"},
+		{[]uax14.Option{keepAll}, "한국어 텍스트", "한국어 |텍스트"},
+	} {
+		seg := segment.NewSegmenter(uax14.NewLineWrap(test.opts...))
+		seg.InitFromString(test.text)
+		var segments []string
+		for seg.Next() {
+			segments = append(segments, seg.Text())
+		}
+		if s := strings.Join(segments, "|"); s != test.segments {
+			t.Errorf("test #%d: expected %q, have %q", i, test.segments, s)
+		}
+	}
+}
+
+func TestKinsoku(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	normal := uax14.CSSLineBreak(uax14.LineBreakNormal)
+	custom := &uax14.Kinsoku{NotStarting: "と", NotEnding: "待", Penalty: 500}
+	text := "ちょっと待って（本当に）。"
+	for i, test := range []struct {
+		rules     *uax14.Kinsoku
+		penalties string
+	}{
+		{nil, "ち/1|ょ/1|っ/1|と/1|待/1|っ/1|て/1|（本/1|当/1|に）。/-19000"},
+		{uax14.WeakKinsoku(), "ち/1|ょ/1|っ/1|と/1|待/1|っ/1|て/1|（本/1|当/1|に）。/-19000"},
+		{uax14.StandardKinsoku(), "ち/1001|ょ/1001|っ/1|と/1|待/1001|っ/1|て/1|（本/1|当/1|に）。/-19000"},
+		{custom, "ち/1|ょ/1|っ/501|と/1|待/501|っ/1|て/1|（本/1|当/1|に）。/-19000"},
+	} {
+		seg := segment.NewSegmenter(uax14.NewLineWrap(normal, uax14.KinsokuShori(test.rules)))
+		seg.InitFromString(text)
+		var segments []string
+		for seg.Next() {
+			p0, _ := seg.Penalties()
+			segments = append(segments, fmt.Sprintf("%s/%d", seg.Text(), p0))
+		}
+		if s := strings.Join(segments, "|"); s != test.penalties {
+			t.Errorf("test #%d: expected %q, have %q", i, test.penalties, s)
+		}
+	}
+	// breaks after opening brackets are penalized, even if followed by spaces
+	seg := segment.NewSegmenter(uax14.NewLineWrap(uax14.CSSLineBreak(uax14.LineBreakAnywhere),
+		uax14.KinsokuShori(uax14.WeakKinsoku())))
+	seg.InitFromString("( x")
+	var segments []string
+	for seg.Next() {
+		p0, _ := seg.Penalties()
+		segments = append(segments, fmt.Sprintf("%s/%d", seg.Text(), p0))
+	}
+	if s := strings.Join(segments, "|"); s != "(/1| /971|x/-19000" {
+		t.Errorf("expected penalty for a line ending with an opening bracket, have %q", s)
+	}
+}
+
+func TestLineWrapPenalties(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	custom := uax14.DefaultPenalties()
+	custom.ForMustBreak = -5000
+	custom.Default = 50
+	for i, test := range []struct {
+		opts      []uax14.Option
+		penalties string
+	}{
+		{nil, "Hello /-29|World\n/-18999|x/-19000"},
+		{[]uax14.Option{uax14.UsePenalties(custom)}, "Hello /20|World\n/-4950|x/-5000"},
+		{[]uax14.Option{uax14.UsePenalties(custom), uax14.CSSLineBreak(uax14.LineBreakAnywhere)},
+			"H/50|e/50|l/50|l/50|o/50| /20|W/50|o/50|r/50|l/50|d/50|\n/-4950|x/-5000"},
+	} {
+		seg := segment.NewSegmenter(uax14.NewLineWrap(test.opts...))
+		seg.InitFromString("Hello World\nx")
+		var segments []string
+		for seg.Next() {
+			p0, _ := seg.Penalties()
+			segments = append(segments, fmt.Sprintf("%s/%d", seg.Text(), p0))
+		}
+		if s := strings.Join(segments, "|"); s != test.penalties {
+			t.Errorf("test #%d: expected %q, have %q", i, test.penalties, s)
+		}
+	}
+}
+
+func TestBrahmicSyllables(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	const (
+		AK = "\u1B13"     // BALINESE LETTER KA
+		AP = "\U00011003" // BRAHMI SIGN JIHVAMULIYA
+		AS = "\u1BC0"     // BATAK LETTER A
+		VF = "\u1BF2"     // BATAK PANGOLAT
+		VI = "\u1B44"     // BALINESE ADEG ADEG
+		CM = "\u1B38"     // BALINESE VOWEL SIGN SUKU
+	)
+	for i, test := range []struct {
+		text     string
+		segments string
+	}{
+		{AP + AK, AP + AK}, // AP × (AK | ◌ | AS)
+		{AS + VF + " " + AK + VI, AS + VF + " |" + AK + VI}, // (AK | ◌ | AS) × (VF | VI)
+		{"◌" + VI + AK + AK, "◌" + VI + AK + "|" + AK},      // (AK | ◌ | AS) VI × (AK | ◌)
+		{AK + VI + AS, AK + VI + "|" + AS},
+		{AK + AK + VF, AK + AK + VF}, // (AK | ◌ | AS) × (AK | ◌ | AS) VF
+		{AK + AK, AK + "|" + AK},
+		{AK + CM + VI + CM + AK, AK + CM + VI + CM + AK}, // combining marks, see LB9
+	} {
+		seg := segment.NewSegmenter(uax14.NewLineWrap())
+		seg.InitFromString(test.text)
+		var segments []string
+		for seg.Next() {
+			segments = append(segments, seg.Text())
+		}
+		if s := strings.Join(segments, "|"); s != test.segments {
+			t.Errorf("test #%d: expected %+q, have %+q", i, test.segments, s)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package uax29
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/npillmayer/uax"
+)
+
+// === Abbreviations =============================================
+
+// Abbreviations is a set of abbreviations, such as "Mr." or "e.g.". It is used
+// by an AbbreviationBreaker to suppress false breaks after and within abbreviations.
+//
+// Abbreviations are single tokens without whitespace, and include their trailing
+// full stop. Lookup is case-sensitive, as in the CLDR sentence break suppressions.
+type Abbreviations struct {
+	words  map[string]struct{}
+	maxlen int // maximum length of an abbreviation in runes
+}
+
+// NewAbbreviations creates a set of abbreviations from a list of words.
+// Words without a trailing full stop will get one appended.
+func NewAbbreviations(words ...string) *Abbreviations {
+	abbrevs := &Abbreviations{words: make(map[string]struct{}, len(words))}
+	abbrevs.Add(words...)
+	return abbrevs
+}
+
+// Add adds words to a set of abbreviations.
+// Words without a trailing full stop will get one appended.
+func (abbrevs *Abbreviations) Add(words ...string) {
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w == "" {
+			continue
+		}
+		if !strings.HasSuffix(w, ".") {
+			w += "."
+		}
+		abbrevs.words[w] = struct{}{}
+		if l := utf8.RuneCountInString(w); l > abbrevs.maxlen {
+			abbrevs.maxlen = l
+		}
+	}
+}
+
+// Contains checks if word is contained in the set of abbreviations.
+func (abbrevs *Abbreviations) Contains(word string) bool {
+	if abbrevs == nil {
+		return false
+	}
+	_, ok := abbrevs.words[word]
+	return ok
+}
+
+// Len returns the number of abbreviations in the set.
+func (abbrevs *Abbreviations) Len() int {
+	return len(abbrevs.words)
+}
+
+// LoadAbbreviations reads a list of abbreviations from a simple text format:
+// one abbreviation per line, empty lines and lines starting with '#' are
+// ignored.
+//
+//   # English titles
+//   Mr.
+//   Mrs.
+//   Dr.
+//
+// Entries must not contain whitespace.
+func LoadAbbreviations(r io.Reader) (*Abbreviations, error) {
+	abbrevs := NewAbbreviations()
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.IndexFunc(line, unicode.IsSpace) >= 0 {
+			return nil, fmt.Errorf("abbreviation in line %d contains whitespace: %q", lineno, line)
+		}
+		abbrevs.Add(line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return abbrevs, nil
+}
+
+// LoadAbbreviationsFile reads a list of abbreviations from a text file.
+// See LoadAbbreviations for the format.
+func LoadAbbreviationsFile(filename string) (*Abbreviations, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadAbbreviations(f)
+}
+
+// AbbreviationsForLanguage returns a set of common abbreviations for a language.
+// lang is a language tag like "en" or "de-AT"; only the primary language
+// subtag is considered. Currently English and German are supported.
+//
+// Each call returns a new set, so clients are free to Add() to it.
+func AbbreviationsForLanguage(lang string) (*Abbreviations, error) {
+	primary := strings.ToLower(lang)
+	if i := strings.IndexAny(primary, "-_"); i >= 0 {
+		primary = primary[:i]
+	}
+	switch primary {
+	case "en":
+		return NewAbbreviations(englishAbbreviations...), nil
+	case "de":
+		return NewAbbreviations(germanAbbreviations...), nil
+	}
+	return nil, fmt.Errorf("no abbreviations available for language %q", lang)
+}
+
+var englishAbbreviations = []string{
+	"Mr.", "Mrs.", "Ms.", "Dr.", "Prof.", "Sr.", "Jr.", "St.", "Mt.", "Rev.",
+	"Gen.", "Gov.", "Sen.", "Rep.", "Capt.", "Lt.", "Col.", "Sgt.", "Hon.",
+	"Ph.D.", "M.D.", "B.A.", "M.A.", "B.Sc.", "M.Sc.",
+	"e.g.", "i.e.", "cf.", "vs.", "etc.", "approx.", "ca.", "viz.", "al.",
+	"Inc.", "Ltd.", "Co.", "Corp.", "Bros.", "Dept.", "Univ.",
+	"No.", "Nos.", "Fig.", "Figs.", "Vol.", "Vols.", "Ch.", "Sec.", "pp.", "ed.", "eds.",
+	"Jan.", "Feb.", "Mar.", "Apr.", "Jun.", "Jul.", "Aug.", "Sep.", "Sept.",
+	"Oct.", "Nov.", "Dec.",
+	"a.m.", "p.m.", "U.S.", "U.S.A.", "U.K.", "E.U.", "U.N.",
+}
+
+var germanAbbreviations = []string{
+	"Hr.", "Hrn.", "Fr.", "Dr.", "Prof.", "Dipl.", "Ing.", "Hrsg.", "Mag.",
+	"z.B.", "d.h.", "u.a.", "o.a.", "u.Ä.", "o.Ä.", "u.ä.", "o.ä.", "s.o.", "s.u.",
+	"u.U.", "z.T.", "i.d.R.", "z.Zt.", "v.a.", "m.E.", "u.v.m.",
+	"usw.", "bzw.", "ca.", "vgl.", "evtl.", "ggf.", "inkl.", "exkl.", "etc.",
+	"sog.", "bspw.", "allg.", "ebd.", "zzgl.", "abzgl.", "gem.", "lt.",
+	"Nr.", "Abs.", "Abb.", "Bd.", "Bde.", "Kap.", "Tab.", "Anm.", "Aufl.", "S.", "ff.",
+	"Jh.", "Jhd.", "Mio.", "Mrd.", "Tel.", "Str.", "St.",
+	"Jan.", "Feb.", "Mär.", "Apr.", "Jun.", "Jul.", "Aug.", "Sep.", "Sept.",
+	"Okt.", "Nov.", "Dez.",
+}
+
+// === Abbreviation Breaker ======================================
+
+// AbbreviationBreaker is a secondary breaker which suppresses false breaks at
+// abbreviations. It implements the uax.UnicodeBreaker interface and is intended to
+// be used next to a WordBreaker or a SentenceBreaker:
+//
+//   en, _ := uax29.AbbreviationsForLanguage("en")
+//   segmenter := segment.NewSegmenter(uax29.NewSentenceBreaker(1), uax29.NewAbbreviationBreaker(en))
+//   segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+//
+// It puts uax.InfinitePenalty between the runes of an abbreviation, i.e., "U.S.A."
+// will not be broken up into words. Additionally, it puts uax.InfinitePenalty
+// after an abbreviation and any trailing closing punctuation and spaces, where a
+// sentence breaker would otherwise break. When used next to a word breaker,
+// clients should switch this off with SuppressSentenceBreaks(false).
+//
+// The segmenter will honour these penalties only with boundary policy
+// segment.SecondaryVeto.
+type AbbreviationBreaker struct {
+	abbrevs   []*Abbreviations
+	maxlen    int    // longest abbreviation of all sets, in runes
+	token     []rune // current run of letters, digits and full stops
+	isAbbrev  bool   // token has been identified as an abbreviation
+	trailing  int    // number of closing punctuation or space runes after an abbreviation
+	sentences bool   // suppress sentence breaks after abbreviations
+	penalties []int  // returned to the segmenter: penalties to insert
+}
+
+// NewAbbreviationBreaker creates a new breaker for one or more sets of abbreviations.
+func NewAbbreviationBreaker(abbrevs ...*Abbreviations) *AbbreviationBreaker {
+	ab := &AbbreviationBreaker{sentences: true}
+	for _, a := range abbrevs {
+		if a == nil {
+			continue
+		}
+		ab.abbrevs = append(ab.abbrevs, a)
+		if a.maxlen > ab.maxlen {
+			ab.maxlen = a.maxlen
+		}
+	}
+	SetupSentenceClasses()
+	return ab
+}
+
+// SuppressSentenceBreaks configures whether the breaker will suppress breaks
+// after an abbreviation (and any trailing closing punctuation and spaces).
+// Default is true. Breaks within abbreviations will be suppressed in any case.
+func (ab *AbbreviationBreaker) SuppressSentenceBreaks(on bool) {
+	ab.sentences = on
+}
+
+// CodePointClassFor returns the UAX#29 sentence code-point class for a rune (= code-point).
+// (Interface uax.UnicodeBreaker)
+func (ab *AbbreviationBreaker) CodePointClassFor(r rune) int {
+	return int(SentenceClassForRune(r))
+}
+
+// StartRulesFor is part of interface uax.UnicodeBreaker.
+// The abbreviation breaker does not use recognizers, thus this is a no-op.
+func (ab *AbbreviationBreaker) StartRulesFor(r rune, cpClass int) {
+}
+
+// ProceedWithRune is a signal:
+// A new code-point has been read and this breaker receives a message to
+// consume it.
+// (Interface uax.UnicodeBreaker)
+func (ab *AbbreviationBreaker) ProceedWithRune(r rune, cpClass int) {
+	c := SentenceClass(cpClass)
+	ab.penalties = ab.penalties[:0]
+	if ab.isAbbrev {
+		if c == SBCloseClass || c == SBSpClass || sbExtendFormat(c) {
+			ab.trailing++
+			return
+		}
+		ab.suppress(c != sbeot)
+	} else if len(ab.token) > 0 && !isAbbrevRune(r, c) {
+		if ab.lookup() {
+			ab.isAbbrev = true
+			ab.trailing = 0
+			ab.ProceedWithRune(r, cpClass)
+			return
+		}
+		ab.token = ab.token[:0]
+	}
+	if isAbbrevRune(r, c) {
+		if len(ab.token) <= ab.maxlen { // longer tokens will never match
+			ab.token = append(ab.token, r)
+		}
+	}
+}
+
+// suppress puts penalties between the runes of an abbreviation and, optionally,
+// before the current rune. We are called for the first rune after an abbreviation
+// and its trailing closing punctuation and spaces.
+func (ab *AbbreviationBreaker) suppress(sentence bool) {
+	tracer().Debugf("suppressing breaks for abbreviation %q", string(ab.token))
+	l := len(ab.token) + ab.trailing + 1
+	for i := 0; i < l; i++ {
+		ab.penalties = append(ab.penalties, 0)
+	}
+	for i := ab.trailing + 2; i < l; i++ {
+		ab.penalties[i] = uax.InfinitePenalty
+	}
+	if ab.sentences && sentence {
+		ab.penalties[1] = uax.InfinitePenalty
+	}
+	ab.token = ab.token[:0]
+	ab.isAbbrev = false
+	ab.trailing = 0
+}
+
+func (ab *AbbreviationBreaker) lookup() bool {
+	if len(ab.token) > ab.maxlen || ab.token[len(ab.token)-1] != '.' {
+		return false
+	}
+	word := string(ab.token)
+	for _, a := range ab.abbrevs {
+		if a.Contains(word) {
+			return true
+		}
+	}
+	return false
+}
+
+// LongestActiveMatch returns the length of a possible abbreviation
+// currently being read, including trailing closing punctuation and spaces.
+// (Interface uax.UnicodeBreaker)
+func (ab *AbbreviationBreaker) LongestActiveMatch() int {
+	if len(ab.token) > ab.maxlen {
+		return 0
+	}
+	return len(ab.token) + ab.trailing
+}
+
+// Penalties is part of interface uax.UnicodeBreaker.
+func (ab *AbbreviationBreaker) Penalties() []int {
+	return ab.penalties
+}
+
+// Runes which may be part of an abbreviation: letters, digits and full stops.
+func isAbbrevRune(r rune, c SentenceClass) bool {
+	return r == '.' || c == SBATermClass ||
+		unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,92 @@
+package uax29_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func ExampleAbbreviationBreaker() {
+	en, _ := uax29.AbbreviationsForLanguage("en")
+	onSentences := uax29.NewSentenceBreaker(1)
+	segmenter := segment.NewSegmenter(onSentences, uax29.NewAbbreviationBreaker(en))
+	segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+	segmenter.Init(strings.NewReader("This is Mr. Smith. He lives in the U.S.A. since Jan. 2020."))
+	for segmenter.Next() {
+		fmt.Printf("'%s'\n", segmenter.Text())
+	}
+	// Output: 'This is Mr. Smith. '
+	// 'He lives in the U.S.A. since Jan. 2020.'
+}
+
+func TestAbbreviationsGerman(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	de, err := uax29.AbbreviationsForLanguage("de-AT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	segmenter := segment.NewSegmenter(uax29.NewSentenceBreaker(1), uax29.NewAbbreviationBreaker(de))
+	segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+	segmenter.Init(strings.NewReader("Das ist z.B. Hr. Maier. Er wohnt im Haus Nr. 5 usw."))
+	n := 0
+	for segmenter.Next() {
+		t.Logf("'%s'", segmenter.Text())
+		n++
+	}
+	if n != 2 {
+		t.Errorf("expected 2 sentences, have %d", n)
+	}
+}
+
+func TestAbbreviationsWords(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	en, _ := uax29.AbbreviationsForLanguage("en")
+	abbrevs := uax29.NewAbbreviationBreaker(en)
+	abbrevs.SuppressSentenceBreaks(false)
+	segmenter := segment.NewSegmenter(uax29.NewWordBreaker(1), abbrevs)
+	segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+	segmenter.Init(strings.NewReader("the U.S.A. (e.g. Mr. Smith)"))
+	var words []string
+	for segmenter.Next() {
+		words = append(words, segmenter.Text())
+	}
+	expected := []string{"the", " ", "U.S.A.", " ", "(", "e.g.", " ", "Mr.", " ", "Smith", ")"}
+	if strings.Join(words, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected words %q, have %q", expected, words)
+	}
+}
+
+func TestLoadAbbreviations(t *testing.T) {
+	list := `# custom abbreviations
+Approx.
+	Abt.
+
+Ca
+`
+	abbrevs, err := uax29.LoadAbbreviations(strings.NewReader(list))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if abbrevs.Len() != 3 {
+		t.Errorf("expected 3 abbreviations, have %d", abbrevs.Len())
+	}
+	for _, w := range []string{"Approx.", "Abt.", "Ca."} {
+		if !abbrevs.Contains(w) {
+			t.Errorf("expected abbreviation %q to be loaded", w)
+		}
+	}
+	if _, err = uax29.LoadAbbreviations(strings.NewReader("et al.\n")); err == nil {
+		t.Errorf("expected entry with whitespace to be rejected")
+	}
+	if _, err = uax29.AbbreviationsForLanguage("xx"); err == nil {
+		t.Errorf("expected unknown language to be rejected")
+	}
+}
@@ -0,0 +1,103 @@
+package uax29
+
+import (
+	"unicode"
+
+	"github.com/npillmayer/uax/emoji"
+	"github.com/npillmayer/uax/internal/dfa"
+)
+
+//go:generate go run ./internal/dfagenerator
+
+// FastWordBreaker is a word breaker driven by a deterministic automaton.
+// The automaton is compiled from the rules of WordBreaker, and both give
+// identical results, with FastWordBreaker being several times faster.
+// It implements the uax.UnicodeBreaker interface.
+//
+// FastWordBreaker always reports the default penalties (see DefaultPenalties).
+// It does not support dictionaries, penalty aggregators nor rule observers;
+// clients needing them should use WordBreaker.
+type FastWordBreaker struct {
+	machine   *dfa.Machine
+	penalties []int
+	weight    int
+}
+
+// NewFastWordBreaker creates a new word breaker driven by a deterministic
+// automaton.
+//
+// weight is a multiplying factor for penalties. It must be 1…w…5 and will
+// be capped for values outside this range.
+func NewFastWordBreaker(weight int) *FastWordBreaker {
+	SetupUAX29Classes()
+	return &FastWordBreaker{machine: dfa.NewMachine(wordDFA), weight: capw(weight)}
+}
+
+// Input symbols of the automaton, following the UAX#29 classes. Rule WB3c
+// needs letters to be told apart by their Extended_Pictographic property.
+const (
+	fastOther              = int(emojiPictographic) + 1
+	fastEOT                = int(emojiPictographic) + 2
+	fastPictographicLetter = int(emojiPictographic) + 3
+)
+
+// CodePointClassFor returns the input symbol of the automaton for a rune.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastWordBreaker) CodePointClassFor(r rune) int {
+	switch c := ClassForRune(r); c {
+	case eot:
+		return fastEOT
+	case Other:
+		if unicode.Is(emoji.Extended_Pictographic, r) {
+			return int(emojiPictographic)
+		}
+		return fastOther
+	case ALetterClass:
+		if unicode.Is(emoji.Extended_Pictographic, r) {
+			return fastPictographicLetter
+		}
+		return int(c)
+	default:
+		return int(c)
+	}
+}
+
+// StartRulesFor does nothing, as there are no rules to start.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastWordBreaker) StartRulesFor(r rune, cpClass int) {}
+
+// ProceedWithRune lets the automaton read a rune.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastWordBreaker) ProceedWithRune(r rune, cpClass int) {
+	fb.penalties = fb.machine.Step(cpClass)
+	scale(fb.penalties, fb.weight)
+}
+
+// LongestActiveMatch returns the distance to the first position the automaton
+// has not yet decided on.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastWordBreaker) LongestActiveMatch() int {
+	return fb.machine.LongestActiveMatch()
+}
+
+// Penalties returns the penalties for the most recently read rune.
+// (Interface uax.UnicodeBreaker)
+func (fb *FastWordBreaker) Penalties() []int {
+	return fb.penalties
+}
+
+// CodePointType returns the type of a code-point, just like
+// WordBreaker.CodePointType.
+//
+// (Interface uax.WordTyper)
+func (fb *FastWordBreaker) CodePointType(r rune, cpClass int) int {
+	switch cpClass {
+	case fastEOT:
+		return None
+	case fastOther:
+		return wordTypeOf(r, Other)
+	case fastPictographicLetter:
+		return wordTypeOf(r, ALetterClass)
+	}
+	return wordTypeOf(r, UAX29Class(cpClass))
+}
@@ -0,0 +1,117 @@
+package uax29_test
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/internal/ucdparse"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func TestFastWordBreakTestFile(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	seg := segment.NewSegmenter(uax29.NewFastWordBreaker(1))
+	tf := ucdparse.OpenTestFile("./WordBreakTest.txt", t)
+	defer tf.Close()
+	failcnt, i := 0, 0
+	for tf.Scan() {
+		i++
+		in, out := ucdparse.BreakTestInput(tf.Text())
+		if !executeSingleTest(t, seg, i, in, out) {
+			failcnt++
+		}
+	}
+	if err := tf.Err(); err != nil {
+		t.Errorf("reading input: %s", err)
+	}
+	if failcnt > 0 {
+		t.Errorf("%d TEST CASES OUT of %d FAILED", failcnt, i)
+	}
+}
+
+// Differential test: the automaton has to report the same segments with the
+// same penalties as the rules it has been compiled from.
+func TestFastWordBreakerDifferential(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	fast := uax29.NewFastWordBreaker(2)
+	symbols := runesBySymbol(fast.CodePointClassFor)
+	rules := segment.NewSegmenter(uax29.NewWordBreaker(2))
+	auto := segment.NewSegmenter(fast)
+	random := rand.New(rand.NewSource(29))
+	for i := 0; i < 20000; i++ {
+		text := randomText(random, symbols, 1+random.Intn(12))
+		if expected, s := segmentsOf(rules, text), segmentsOf(auto, text); s != expected {
+			t.Fatalf("test #%d: %+q: expected %q, have %q", i, text, expected, s)
+		}
+	}
+}
+
+// runesBySymbol collects the code-points for every input symbol of an automaton.
+func runesBySymbol(symbolFor func(rune) int) [][]rune {
+	var symbols [][]rune
+	for r := rune(1); r <= unicode.MaxRune; r++ {
+		sym := symbolFor(r)
+		for len(symbols) <= sym {
+			symbols = append(symbols, nil)
+		}
+		symbols[sym] = append(symbols[sym], r)
+	}
+	return symbols
+}
+
+// randomText creates a text of n code-points, with every input symbol being
+// equally likely.
+func randomText(random *rand.Rand, symbols [][]rune, n int) string {
+	var b strings.Builder
+	for i := 0; i < n; {
+		if runes := symbols[random.Intn(len(symbols))]; len(runes) > 0 {
+			b.WriteRune(runes[random.Intn(len(runes))])
+			i++
+		}
+	}
+	return b.String()
+}
+
+func segmentsOf(seg *segment.Segmenter, text string) string {
+	seg.InitFromString(text)
+	var segments []string
+	for seg.Next() {
+		p0, _ := seg.Penalties()
+		segments = append(segments, fmt.Sprintf("%s/%d", seg.Text(), p0))
+	}
+	return strings.Join(segments, "|")
+}
+
+func benchmarkWordBreaker(b *testing.B, breaker uax.UnicodeBreaker) {
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	text := strings.Repeat("The quick (“brown”) fox can’t jump 32.3 feet, right? ", 20)
+	seg := segment.NewSegmenter(breaker)
+	b.SetBytes(int64(len(text)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		seg.InitFromString(text)
+		for seg.Next() {
+		}
+	}
+}
+
+func BenchmarkWordBreaker(b *testing.B) {
+	benchmarkWordBreaker(b, uax29.NewWordBreaker(1))
+}
+
+func BenchmarkFastWordBreaker(b *testing.B) {
+	benchmarkWordBreaker(b, uax29.NewFastWordBreaker(1))
+}
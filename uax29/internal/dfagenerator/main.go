@@ -0,0 +1,57 @@
+/*
+Package for a generator of the automaton of uax29.FastWordBreaker.
+
+Contents
+
+The generator compiles the rules of the UAX#29 word breaker into a
+deterministic automaton (see package internal/dfa). The rule-based
+uax29.WordBreaker remains the reference, and tests compare both breakers
+for identical results.
+
+
+Usage
+
+   dfagenerator [-v] [-d depth]
+
+Option -d sets the number of code-points the compiler looks ahead to tell
+states apart. This creates a file "worddfa.go" in the current directory. It is
+designed to be called from the "uax29" directory (see go:generate).
+The automaton has to be regenerated whenever the rules or the code-point
+classes of the word breaker change.
+
+
+License
+
+Governed by a 3-Clause BSD license. License file may be found in the root
+folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/internal/dfa"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func main() {
+	verbose := flag.Bool("v", false, "verbose output")
+	depth := flag.Int("d", 2, "look-ahead to tell states apart")
+	flag.Parse()
+	start := time.Now()
+	table, err := dfa.Generate("uax29", "wordDFA", "worddfa.go", func() uax.UnicodeBreaker {
+		return uax29.NewWordBreaker(1)
+	}, uax29.NewFastWordBreaker(1).CodePointClassFor, *depth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *verbose {
+		log.Printf("compiled %d states over %d input symbols in %v",
+			table.States(), table.Symbols, time.Since(start))
+	}
+}
@@ -26,6 +26,9 @@ be turned on.
 This creates a file "uax29classes.go" in the current directory. It is designed
 to be called from the "uax29" directory.
 
+After regenerating, constant uax29.UnicodeVersion has to be updated to the
+version of the data file, together with the test file "WordBreakTest.txt".
+
 
 License
 
@@ -44,11 +47,14 @@ import (
 	"runtime"
 	"text/template"
 	"time"
+	"unicode"
 
 	"os"
 
 	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/npillmayer/uax/internal/classtable"
 	"github.com/npillmayer/uax/internal/ucdparse"
+	"golang.org/x/text/unicode/rangetable"
 )
 
 var logger = log.New(os.Stderr, "UAX#29 generator: ", log.LstdFlags)
@@ -82,6 +88,9 @@ func loadUnicodeLineBreakFile() (map[string][]rune, error) {
 	for p.Next() {
 		from, to := p.Token.Range()
 		brclzstr := p.Token.Field(1)
+		if brclzstr == "" { // not a data item, e.g. document root
+			continue
+		}
 		list := lbcs[brclzstr]
 		if list == nil {
 			list = arraylist.New()
@@ -121,6 +130,7 @@ import (
     "strconv"
     "unicode"
 
+    "github.com/npillmayer/uax/internal/classtable"
     "golang.org/x/text/unicode/rangetable"
 )
 `
@@ -231,6 +241,21 @@ func generateRanges(w *bufio.Writer, codePointLists map[string][]rune) {
 	w.WriteString("}\n")
 }
 
+// generateLookupTable writes a two-stage lookup table for all classes, to be
+// used by the ClassForRune function.
+func generateLookupTable(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate lookup table")
+	classes := make([]*unicode.RangeTable, len(uax29classnames))
+	for i, key := range uax29classnames {
+		if codepoints, ok := codePointLists[key]; ok {
+			classes[i] = rangetable.New(codepoints...)
+		}
+	}
+	t, err := classtable.Build(classes)
+	checkFatal(err)
+	checkFatal(t.WriteGo(w, "uax29ClassTable"))
+}
+
 func main() {
 	doVerbose := flag.Bool("v", false, "verbose output mode")
 	flag.Parse()
@@ -253,6 +278,7 @@ func main() {
 	t = makeTemplate("UAX#29 classes stringer", templateClassStringer)
 	checkFatal(t.Execute(w, uax29classnames))
 	generateRanges(w, codePointLists)
+	generateLookupTable(w, codePointLists)
 	w.Flush()
 }
 
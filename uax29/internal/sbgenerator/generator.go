@@ -0,0 +1,311 @@
+/*
+Package for a generator for UAX#29 sentence breaking classes.
+
+# BSD License
+
+Copyright (c) 2017–20, Norbert Pillmayer (norbert@pillmayer.com)
+
+# Contents
+
+This is a generator for Unicode UAX#29 sentence breaking code-point classes.
+For more information see http://unicode.org/reports/tr29/
+
+Classes are generated from a UAX#29 companion file: "SentenceBreakProperty.txt".
+This is the definite source for UAX#29 code-point classes. The
+generator looks for it in a directory "$GOPATH/etc/".
+
+# Usage
+
+The generator has just one option, a "verbose" flag. It should usually
+be turned on.
+
+	generator [-v]
+
+This creates a file "sentenceclasses.go" in the current directory. It is designed
+to be called from the "uax29" directory.
+
+# License
+
+Governed by a 3-Clause BSD license. License file may be found in the root
+folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"runtime"
+	"text/template"
+	"time"
+	"unicode"
+
+	"os"
+
+	"github.com/emirpasic/gods/lists/arraylist"
+	"github.com/npillmayer/uax/internal/classtable"
+	"github.com/npillmayer/uax/internal/ucdparse"
+	"golang.org/x/text/unicode/rangetable"
+)
+
+var logger = log.New(os.Stderr, "UAX#29 sentence generator: ", log.LstdFlags)
+
+// flag: verbose output ?
+var verbose bool
+
+var sentenceclassnames = []string{"ATerm", "CR", "Close", "Extend", "Format",
+	"LF", "Lower", "Numeric", "OLetter", "SContinue", "STerm", "Sep", "Sp", "Upper"}
+
+// Load the Unicode UAX#29 definition file: SentenceBreakProperty.txt
+func loadUnicodeSentenceBreakFile() (map[string][]rune, error) {
+	if verbose {
+		logger.Printf("reading SentenceBreakProperty.txt")
+	}
+	defer timeTrack(time.Now(), "loading SentenceBreakProperty.txt")
+	gopath := os.Getenv("GOPATH")
+	f, err := os.Open(gopath + "/etc/SentenceBreakProperty.txt")
+	if err != nil {
+		fmt.Printf("ERROR loading " + gopath + "/etc/SentenceBreakProperty.txt\n")
+		return nil, err
+	}
+	defer f.Close()
+	p, err := ucdparse.New(f)
+	if err != nil {
+		return nil, err
+	}
+	lbcs := make(map[string]*arraylist.List, len(sentenceclassnames))
+	for p.Next() {
+		from, to := p.Token.Range()
+		brclzstr := p.Token.Field(1)
+		if brclzstr == "" { // not a data item, e.g. document root
+			continue
+		}
+		list := lbcs[brclzstr]
+		if list == nil {
+			list = arraylist.New()
+		}
+		for r := from; r <= to; r++ {
+			list.Add(r)
+		}
+		lbcs[brclzstr] = list
+	}
+	err = p.Token.Error
+	if err != nil {
+		log.Fatal(err)
+	}
+	runeranges := make(map[string][]rune)
+	for k, v := range lbcs {
+		runelist := make([]rune, lbcs[k].Size())
+		it := v.Iterator()
+		i := 0
+		for it.Next() {
+			runelist[i] = it.Value().(rune)
+			i++
+		}
+		runeranges[k] = runelist
+	}
+	return runeranges, err
+}
+
+// --- Templates --------------------------------------------------------
+
+var header = `package uax29
+
+// This file has been generated -- you probably should NOT EDIT IT !
+// 
+// BSD License, Copyright (c) 2021, Norbert Pillmayer (norbert@pillmayer.com)
+
+import (
+    "strconv"
+    "unicode"
+
+    "github.com/npillmayer/uax/internal/classtable"
+)
+`
+
+var templateClassType = `
+// Type for UAX#29 sentence code-point classes.
+// Must be convertable to int.
+type SentenceClass int
+
+// Will be initialized in SetupSentenceClasses()
+var rangeFromSentenceClass []*unicode.RangeTable
+`
+
+var templateRangeTableVars = `
+// Range tables for UAX#29 sentence code-point classes.
+// Will be initialized with SetupSentenceClasses().
+// Clients can check with unicode.Is(..., rune){{$i:=0}}
+var {{range .}}{{$i = inc $i}}SB{{.}}, {{if modten $i}}
+    {{end}}{{end}}sbunused *unicode.RangeTable
+`
+
+var templateClassConsts = `
+// These are all the UAX#29 sentence breaking classes.
+const ( {{$i:=0}}
+{{range  .}}    SB{{.}}Class SentenceClass = {{$i}}{{$i = inc $i}}
+{{end}}
+    SBOther SentenceClass = 999
+    sbsot   SentenceClass = 1000 // pseudo class "start of text"
+    sbeot   SentenceClass = 1001 // pseudo class "end of text"
+)
+`
+
+var templateClassStringer = `
+const _SentenceClass_name = "{{range $c,$name := .}}SB{{$name}}Class{{end}}"
+
+var _SentenceClass_index = [...]uint16{0{{startinxs .}} }
+
+// Stringer for type SentenceClass
+func (c SentenceClass) String() string {
+    if c == sbsot {
+        return "sot"
+    } else if c == sbeot {
+        return "eot"
+    } else if c == SBOther {
+        return "Other"
+    } else if c < 0 || c >= SentenceClass(len(_SentenceClass_index)-1) {
+        return "SentenceClass(" + strconv.FormatInt(int64(c), 10) + ")"
+    }
+    return _SentenceClass_name[_SentenceClass_index[c]:_SentenceClass_index[c+1]]
+}
+`
+
+// Helper functions for templates
+var funcMap = template.FuncMap{
+	"modten": func(i int) bool {
+		return i%10 == 0
+	},
+	"modeight": func(i int) bool {
+		return (i+2)%8 == 0
+	},
+	"inc": func(i int) int {
+		return i + 1
+	},
+	"notfirst": func(i int) bool {
+		return i > 0
+	},
+	"startinxs": func(str []string) string {
+		out := ""
+		total := 0
+		for _, s := range str {
+			l := len(s) + 7
+			total += l
+			if (38+len(out))%80 > 72 {
+				out += fmt.Sprintf(",\n    %d", total)
+			} else {
+				out += fmt.Sprintf(", %d", total)
+			}
+		}
+		return out
+	},
+}
+
+func makeTemplate(name string, templString string) *template.Template {
+	if verbose {
+		logger.Printf("creating %s", name)
+	}
+	t := template.Must(template.New(name).Funcs(funcMap).Parse(templString))
+	return t
+}
+
+// --- Main -------------------------------------------------------------
+
+// generateRanges writes the range tables as literals of type unicode.RangeTable.
+// Sentence classes cover large parts of the code-point space (e.g., OLetter), so
+// we pack runes into strided ranges at generation time instead of listing every
+// single rune.
+func generateRanges(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate range tables")
+	w.WriteString("\nfunc setupSentenceClasses() {\n")
+	w.WriteString("    rangeFromSentenceClass = make([]*unicode.RangeTable, int(SBUpperClass)+1)\n")
+	for _, key := range sentenceclassnames {
+		w.WriteString(fmt.Sprintf("    SB%s = _SB%s\n", key, key))
+		w.WriteString(fmt.Sprintf("    rangeFromSentenceClass[int(SB%sClass)] = SB%s\n", key, key))
+	}
+	w.WriteString("}\n")
+	for _, key := range sentenceclassnames {
+		rt := rangetable.New(codePointLists[key]...)
+		w.WriteString(fmt.Sprintf("\n// Range for UAX#29 sentence class %s\n", key))
+		w.WriteString(fmt.Sprintf("var _SB%s = &unicode.RangeTable{\n", key))
+		if len(rt.R16) > 0 {
+			w.WriteString("    R16: []unicode.Range16{\n")
+			for _, r := range rt.R16 {
+				w.WriteString(fmt.Sprintf("        {%#04x, %#04x, %d},\n", r.Lo, r.Hi, r.Stride))
+			}
+			w.WriteString("    },\n")
+		}
+		if len(rt.R32) > 0 {
+			w.WriteString("    R32: []unicode.Range32{\n")
+			for _, r := range rt.R32 {
+				w.WriteString(fmt.Sprintf("        {%#x, %#x, %d},\n", r.Lo, r.Hi, r.Stride))
+			}
+			w.WriteString("    },\n")
+		}
+		if rt.LatinOffset > 0 {
+			w.WriteString(fmt.Sprintf("    LatinOffset: %d,\n", rt.LatinOffset))
+		}
+		w.WriteString("}\n")
+	}
+}
+
+// generateLookupTable writes a two-stage lookup table for all classes, to be
+// used by the ClassForRune function.
+func generateLookupTable(w *bufio.Writer, codePointLists map[string][]rune) {
+	defer timeTrack(time.Now(), "generate lookup table")
+	classes := make([]*unicode.RangeTable, len(sentenceclassnames))
+	for i, key := range sentenceclassnames {
+		if codepoints, ok := codePointLists[key]; ok {
+			classes[i] = rangetable.New(codepoints...)
+		}
+	}
+	t, err := classtable.Build(classes)
+	checkFatal(err)
+	checkFatal(t.WriteGo(w, "sentenceClassTable"))
+}
+
+func main() {
+	doVerbose := flag.Bool("v", false, "verbose output mode")
+	flag.Parse()
+	verbose = *doVerbose
+	codePointLists, err := loadUnicodeSentenceBreakFile()
+	checkFatal(err)
+	if verbose {
+		logger.Printf("loaded %d UAX#29 sentence breaking classes\n", len(codePointLists))
+	}
+	f, ioerr := os.Create("sentenceclasses.go")
+	checkFatal(ioerr)
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	w.WriteString(header)
+	w.WriteString(templateClassType)
+	t := makeTemplate("UAX#29 sentence classes", templateClassConsts)
+	checkFatal(t.Execute(w, sentenceclassnames))
+	t = makeTemplate("UAX#29 sentence range tables", templateRangeTableVars)
+	checkFatal(t.Execute(w, sentenceclassnames))
+	t = makeTemplate("UAX#29 sentence classes stringer", templateClassStringer)
+	checkFatal(t.Execute(w, sentenceclassnames))
+	generateRanges(w, codePointLists)
+	generateLookupTable(w, codePointLists)
+	w.Flush()
+}
+
+// --- Util -------------------------------------------------------------
+
+// Little helper for testing
+func timeTrack(start time.Time, name string) {
+	if verbose {
+		elapsed := time.Since(start)
+		logger.Printf("timing: %s took %s\n", name, elapsed)
+	}
+}
+
+func checkFatal(err error) {
+	_, file, line, _ := runtime.Caller(1)
+	if err != nil {
+		logger.Fatalln(":", file, ":", line, "-", err)
+	}
+}
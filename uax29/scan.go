@@ -0,0 +1,42 @@
+package uax29
+
+import (
+	"iter"
+
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/segment"
+)
+
+var scanWords = segment.SplitFunc(func() uax.UnicodeBreaker {
+	return NewWordBreaker(1)
+})
+
+// ScanWords is a split function for a bufio.Scanner that returns each UAX#29
+// word segment of the input as a token. Contrary to bufio.ScanWords, runs of
+// whitespace and punctuation are returned as tokens as well.
+//
+//   scanner := bufio.NewScanner(r)
+//   scanner.Split(uax29.ScanWords)
+//
+// See segment.SplitFunc.
+func ScanWords(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	return scanWords(data, atEOF)
+}
+
+// Words returns an iterator over the UAX#29 word segments of a string,
+// including runs of whitespace and punctuation:
+//
+//   for w := range uax29.Words("Hello World!") {
+//       fmt.Println(w)
+//   }
+//
+// The segments are substrings of s.
+func Words(s string) iter.Seq[string] {
+	return segment.All(NewWordBreaker(1), s)
+}
+
+// Sentences returns an iterator over the UAX#29 sentences of a string.
+// The sentences are substrings of s.
+func Sentences(s string) iter.Seq[string] {
+	return segment.All(NewSentenceBreaker(1), s)
+}
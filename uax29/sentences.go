@@ -0,0 +1,320 @@
+package uax29
+
+import (
+	"sync"
+
+	"github.com/npillmayer/uax"
+)
+
+// SentenceUnicodeVersion is the UAX#29 version of the sentence breaking classes.
+const SentenceUnicodeVersion = "17.0.0"
+
+// SentenceClassForRune gets the Unicode #UAX29 sentence class for a Unicode code-point.
+func SentenceClassForRune(r rune) SentenceClass {
+	if r == uax.EOT {
+		return sbeot
+	}
+	if c := sentenceClassTable.Lookup(r); c >= 0 {
+		return SentenceClass(c)
+	}
+	return SBOther
+}
+
+var setupSentencesOnce sync.Once
+
+// SetupSentenceClasses creates code-point classes for sentence breaking.
+// (Concurrency-safe).
+//
+// The sentence breaker will call this transparently if it has not been called beforehand.
+func SetupSentenceClasses() {
+	setupSentencesOnce.Do(setupSentenceClasses)
+}
+
+// === Sentence Breaker ==========================================
+
+// SentenceBreaker is a Breaker type used by a uax.Segmenter to break text
+// up according to UAX#29 / Sentences.
+// It implements the uax.UnicodeBreaker interface.
+//
+// UAX#29 sentence rules are formulated in a way that 'no break' is the default
+// (rule SB998). The sentence breaker therefore suppresses every break which is
+// not explicitly allowed by one of the rules SB4 or SB11.
+type SentenceBreaker struct {
+	rules         map[SentenceClass][]uax.NfaStateFn // we manage a set of NFAs
+	publisher     uax.RunePublisher                  // we use the rune publishing mechanism
+	longestMatch  int                                // longest active match for any rule of this sentence breaker
+	penalties     []int                              // returned to the segmenter: penalties to insert
+	weight        int                                // will multiply penalties by this factor
+	profile       PenaltyProfile                     // penalties to emit
+	previousClass SentenceClass                      // class of previously read rune, ignoring Extend and Format
+	deferredBreak bool                               // SB8 will decide about the break before the current rune
+}
+
+// NewSentenceBreaker creates a a new UAX#29 sentence breaker.
+//
+// Usage:
+//
+//   onSentences := NewSentenceBreaker(1)
+//   segmenter := uax.NewSegmenter(onSentences)
+//   segmenter.Init(...)
+//   for segmenter.Next() ...
+//
+// weight is a multiplying factor for penalties. It must be 1…w…5 and will
+// be capped for values outside this range. Clients may provide a penalty
+// profile to replace the default penalties (see DefaultPenalties).
+//
+func NewSentenceBreaker(weight int, profile ...PenaltyProfile) *SentenceBreaker {
+	sb := &SentenceBreaker{weight: capw(weight), profile: DefaultPenalties(), previousClass: sbsot}
+	if len(profile) > 0 {
+		sb.profile = profile[0]
+	}
+	sb.publisher = uax.NewRunePublisher()
+	sb.rules = map[SentenceClass][]uax.NfaStateFn{
+		SBCRClass:    {rule_SB4},
+		SBLFClass:    {rule_SB4},
+		SBSepClass:   {rule_SB4},
+		SBATermClass: {rule_SB11},
+		SBSTermClass: {rule_SB11},
+	}
+	if rangeFromSentenceClass == nil {
+		tracer().Infof("UAX#29 sentence classes not yet initialized -> initializing")
+	}
+	SetupSentenceClasses()
+	return sb
+}
+
+// SetPenaltyAggregator sets the function to aggregate penalties of rules
+// firing at the same position. The default is uax.AddPenalties.
+func (sb *SentenceBreaker) SetPenaltyAggregator(pa uax.PenaltyAggregator) {
+	sb.publisher.SetPenaltyAggregator(pa)
+}
+
+// SetRuleObserver sets a callback which is informed about rules being started,
+// accepting or aborting. Setting nil switches reporting off.
+//
+// (Interface uax.RuleObservable)
+func (sb *SentenceBreaker) SetRuleObserver(obs uax.RuleObserver) {
+	sb.publisher.SetRuleObserver(obs)
+}
+
+// CodePointClassFor returns the UAX#29 sentence code-point class for a rune (= code-point).
+// (Interface uax.UnicodeBreaker)
+func (sb *SentenceBreaker) CodePointClassFor(r rune) int {
+	return int(SentenceClassForRune(r))
+}
+
+// StartRulesFor starts all recognizers where the starting symbol is rune r.
+// r is of code-point-class cpClass.
+// (Interface uax.UnicodeBreaker)
+func (sb *SentenceBreaker) StartRulesFor(r rune, cpClass int) {
+	c := SentenceClass(cpClass)
+	if rules := sb.rules[c]; len(rules) > 0 {
+		tracer().P("class", c).Debugf("starting %d rule(s) for class %s", len(rules), c)
+		for _, rule := range rules {
+			rec := uax.NewPooledRecognizer(cpClass, rule) // rec.Expect holds the starting class
+			rec.UserData = sb
+			sb.publisher.SubscribeMe(rec)
+		}
+	}
+}
+
+// ProceedWithRune is a signal:
+// A new code-point has been read and this breaker receives a message to
+// consume it.
+// (Interface uax.UnicodeBreaker)
+func (sb *SentenceBreaker) ProceedWithRune(r rune, cpClass int) {
+	c := SentenceClass(cpClass)
+	tracer().P("class", c).Debugf("proceeding with rune %#U ...", r)
+	sb.longestMatch, sb.penalties = sb.publisher.PublishRuneEvent(r, int(c))
+	tracer().P("class", c).Debugf("...done with |match|=%d and p=%v", sb.longestMatch, sb.penalties)
+	if !sbExtendFormat(c) { // SB5: ignore Extend and Format
+		sb.previousClass = c
+	}
+	if c == sbeot {
+		sb.setPenalty1(sb.profile.ForBreak) // SB2: Any ÷ eot
+	} else if sb.deferredBreak {
+		sb.deferredBreak = false
+	} else {
+		sb.setPenalty1(sb.profile.ToSuppressBreak) // SB998: Any × Any, if no other rule applied
+	}
+	scale(sb.penalties, sb.weight)
+}
+
+// LongestActiveMatch collects
+// from all active recognizers information about current match length
+// and return the longest one for all still active recognizers.
+// (Interface uax.UnicodeBreaker)
+func (sb *SentenceBreaker) LongestActiveMatch() int {
+	return sb.longestMatch
+}
+
+// Penalties gets all active penalties for all active recognizers combined.
+// Index 0 belongs to the most recently read rune, i.e., represents
+// the penalty for breaking after it.
+// (Interface uax.UnicodeBreaker)
+func (sb *SentenceBreaker) Penalties() []int {
+	return sb.penalties
+}
+
+func (sb *SentenceBreaker) setPenalty1(p int) {
+	if len(sb.penalties) == 0 {
+		sb.penalties = append(sb.penalties, 0)
+		sb.penalties = append(sb.penalties, p)
+	} else if len(sb.penalties) == 1 {
+		sb.penalties = append(sb.penalties, p)
+	} else if sb.penalties[1] == 0 {
+		sb.penalties[1] = p
+	}
+}
+
+// --- Rules ------------------------------------------------------------
+
+// start ParaSep ÷
+//
+// We cannot insert the mandatory break right away, as the default rule SB998
+// would suppress it with the next rune. Therefore we wait for the next rune
+// and put the break between ParaSep and that rune.
+func rule_SB4(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	return finish_SB4
+}
+
+// SB3: CR × LF, otherwise ParaSep ÷ ...
+func finish_SB4(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if SentenceClass(rec.Expect) == SBCRClass && c == SBLFClass {
+		return uax.DoAbort(rec) // LF will start its own rule SB4
+	}
+	return uax.DoAccept(rec, 0, sbPenalties(rec).ForMustBreak)
+}
+
+// start SATerm Close* Sp* ParaSep? ÷
+//
+// This rule is the only one to allow a break within a sentence, i.e. not at a
+// paragraph separator. The rules SB6, SB7, SB8 and SB8a are exceptions to it and are
+// integrated into the recognizer for SB11.
+func rule_SB11(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	rec.MatchLen++
+	if SentenceClass(cpClass) == SBATermClass {
+		sb := rec.UserData.(*SentenceBreaker)
+		if sb.previousClass == SBUpperClass || sb.previousClass == SBLowerClass {
+			return cont_SB7
+		}
+		return cont_SB6
+	}
+	return cont_SB11_Close
+}
+
+// SB7: (Upper | Lower) ATerm × Upper
+func cont_SB7(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if sbSkipExtendFormat(rec, c) {
+		return cont_SB7
+	}
+	if c == SBUpperClass {
+		return uax.DoAbort(rec)
+	}
+	return cont_SB6(rec, r, cpClass)
+}
+
+// SB6: ATerm × Numeric
+func cont_SB6(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if sbSkipExtendFormat(rec, c) {
+		return cont_SB6
+	}
+	if c == SBNumericClass {
+		return uax.DoAbort(rec)
+	}
+	return cont_SB11_Close(rec, r, cpClass)
+}
+
+// ... Close* ...
+func cont_SB11_Close(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if sbSkipExtendFormat(rec, c) {
+		return cont_SB11_Close
+	}
+	if c == SBCloseClass {
+		rec.MatchLen++
+		return cont_SB11_Close
+	}
+	return cont_SB11_Sp(rec, r, cpClass)
+}
+
+// ... Sp* ...
+func cont_SB11_Sp(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if sbSkipExtendFormat(rec, c) {
+		return cont_SB11_Sp
+	}
+	if c == SBSpClass {
+		rec.MatchLen++
+		return cont_SB11_Sp
+	}
+	return finish_SB11(rec, r, cpClass)
+}
+
+// ... ÷ (unless SB8 or SB8a apply)
+func finish_SB11(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if sbParaSep(c) {
+		return uax.DoAbort(rec) // SB9, SB10: no break before ParaSep; SB4 will break after it
+	}
+	if c == SBSContinueClass || sbSATerm(c) { // SB8a
+		return uax.DoAbort(rec)
+	}
+	if SentenceClass(rec.Expect) == SBATermClass {
+		rec.Expect = rec.MatchLen // misuse of expect field: mark position of break
+		sb := rec.UserData.(*SentenceBreaker)
+		sb.deferredBreak = true // SB998 must not suppress the break we may insert later
+		return cont_SB8(rec, r, cpClass)
+	}
+	return uax.DoAccept(rec, 0, sbPenalties(rec).ForBreak)
+}
+
+// SB8: ATerm Close* Sp* × ( ¬(OLetter | Upper | Lower | ParaSep | SATerm) )* Lower
+func cont_SB8(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
+	c := SentenceClass(cpClass)
+	if c == SBLowerClass {
+		p := make([]int, rec.MatchLen-rec.Expect+2)
+		p[len(p)-1] = sbPenalties(rec).ToSuppressBreak
+		return uax.DoAccept(rec, p...)
+	}
+	if c == SBOLetterClass || c == SBUpperClass || sbParaSep(c) || sbSATerm(c) || c == sbeot {
+		p := make([]int, rec.MatchLen-rec.Expect+2)
+		p[len(p)-1] = sbPenalties(rec).ForBreak
+		return uax.DoAccept(rec, p...)
+	}
+	rec.MatchLen++
+	return cont_SB8
+}
+
+// --- Helpers ---------------------------------------------------------------
+
+// sbPenalties returns the penalty profile of the sentence breaker a recognizer
+// has been started by.
+func sbPenalties(rec *uax.Recognizer) *PenaltyProfile {
+	return &rec.UserData.(*SentenceBreaker).profile
+}
+
+// SB5: ignore Extend and Format within rules.
+func sbSkipExtendFormat(rec *uax.Recognizer, c SentenceClass) bool {
+	if sbExtendFormat(c) {
+		rec.MatchLen++
+		return true
+	}
+	return false
+}
+
+func sbExtendFormat(c SentenceClass) bool {
+	return c == SBExtendClass || c == SBFormatClass
+}
+
+func sbParaSep(c SentenceClass) bool {
+	return c == SBSepClass || c == SBCRClass || c == SBLFClass
+}
+
+func sbSATerm(c SentenceClass) bool {
+	return c == SBATermClass || c == SBSTermClass
+}
@@ -0,0 +1,99 @@
+package uax29_test
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/internal/ucdparse"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax29"
+)
+
+func ExampleSentenceBreaker() {
+	onSentences := uax29.NewSentenceBreaker(1)
+	segmenter := segment.NewSegmenter(onSentences)
+	segmenter.Init(strings.NewReader("This is Mr. Smith. He said: “Hello!” Then he left."))
+	for segmenter.Next() {
+		fmt.Printf("'%s'\n", segmenter.Text())
+	}
+	// Output: 'This is Mr. '
+	// 'Smith. '
+	// 'He said: “Hello!” '
+	// 'Then he left.'
+}
+
+func ExampleSentences() {
+	for s := range uax29.Sentences("This is Mr. Smith. He is here.") {
+		fmt.Printf("'%s'\n", s)
+	}
+	// Output: 'This is Mr. '
+	// 'Smith. '
+	// 'He is here.'
+}
+
+func TestSentenceBreaks1(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	//
+	onSentences := uax29.NewSentenceBreaker(1)
+	segmenter := segment.NewSegmenter(onSentences)
+	segmenter.Init(strings.NewReader("It costs 3.50 e.g. for etc. a pear. Is it ok?\nYes."))
+	n := 0
+	for segmenter.Next() {
+		t.Logf("'%s'\n", segmenter.Text())
+		n++
+	}
+	if n != 3 {
+		t.Errorf("Expected # of segments to be 3, is %d", n)
+	}
+}
+
+func TestSentenceUnicodeVersion(t *testing.T) {
+	f, err := os.Open("./SentenceBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	header, _ := bufio.NewReader(f).ReadString('\n')
+	if expected := "# SentenceBreakTest-" + uax29.SentenceUnicodeVersion + ".txt"; strings.TrimSpace(header) != expected {
+		t.Errorf("expected test file %q for SentenceUnicodeVersion, have %q", expected, strings.TrimSpace(header))
+	}
+}
+
+func TestSentenceBreakTestFile(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracer := tracing.Select("uax.segment")
+	//
+	onSentenceBreak := uax29.NewSentenceBreaker(1)
+	seg := segment.NewSegmenter(onSentenceBreak)
+	tf := ucdparse.OpenTestFile("./SentenceBreakTest.txt", t)
+	defer tf.Close()
+	failcnt, i, from, to := 0, 0, 1, 1000
+	for tf.Scan() {
+		i++
+		if i >= from {
+			tracer.Infof(tf.Comment())
+			in, out := ucdparse.BreakTestInput(tf.Text())
+			if !executeSingleTest(t, seg, i, in, out) {
+				failcnt++
+			}
+		}
+		if i >= to {
+			break
+		}
+	}
+	if err := tf.Err(); err != nil {
+		t.Errorf("reading input: %s", err)
+	}
+	if failcnt > 0 {
+		t.Errorf("%d TEST CASES OUT of %d FAILED", failcnt, i-from+1)
+	} else {
+		t.Logf("%d TEST CASES OUT of %d FAILED", failcnt, i-from+1)
+	}
+}
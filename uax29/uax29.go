@@ -1,5 +1,5 @@
 /*
-Package uax29 implements Unicode Annex #29 word breaking.
+Package uax29 implements Unicode Annex #29 word and sentence breaking.
 
 Content
 
@@ -7,10 +7,16 @@ UAX#29 is the Unicode Annex for breaking text into graphemes, words
 and sentences.
 It defines code-point classes and sets of rules
 for how to place break points and break inhibitors.
-This file is about word breaking.
+This package is about word breaking and sentence breaking.
 
-This segmenter passes all 1823 tests of the Unicode UAX#29 test suite
-for word breaking.
+This segmenter passes all 1944 tests of the Unicode UAX#29 test suite
+for word breaking. Code-point classes, emoji tables and the test file are
+those of the Unicode version given by UnicodeVersion, and so are the word
+breaking rules.
+
+The sentence breaker passes all 512 tests of the Unicode
+UAX#29 test suite for sentence breaking, of the version given by
+SentenceUnicodeVersion.
 
 Typical Usage
 
@@ -22,6 +28,32 @@ breaking engine for a segmenter.
   segmenter.Init(...)
   for segmenter.Next() ...
 
+Word breakers report the type of the words they find, e.g., to filter out
+punctuation and white space:
+
+  for segmenter.Next() {
+      if segmenter.WordType() == uax29.Letter ...
+  }
+
+Sentence breaking works the same way, using a SentenceBreaker:
+
+  onSentences := uax29.NewSentenceBreaker(1)
+  segmenter := uax.NewSegmenter(onSentences)
+
+UAX#29 rules alone will break sentences after abbreviations like "Mr.". Clients
+may add an AbbreviationBreaker as a secondary breaker to suppress these breaks:
+
+  en, _ := uax29.AbbreviationsForLanguage("en")
+  segmenter := uax.NewSegmenter(onSentences, uax29.NewAbbreviationBreaker(en))
+  segmenter.SetBoundaryPolicy(segment.SecondaryVeto)
+
+FastWordBreaker is a faster alternative to WordBreaker. It is driven by a
+deterministic automaton compiled from the rules of WordBreaker and finds the
+same boundaries, but does not support dictionaries, penalty profiles or rule
+observers:
+
+  segmenter := uax.NewSegmenter(uax29.NewFastWordBreaker(1))
+
 Attention
 
 Before using word breakers, clients usually should initialize the classes and rules:
@@ -31,6 +63,7 @@ Before using word breakers, clients usually should initialize the classes and ru
 This initializes all the code-point range tables. Initialization is
 not done beforehand, as it consumes quite some memory. However, the
 word breaker will call it if range tables are not yet initialized.
+The same holds for SetupSentenceClasses() and the sentence breaker.
 
 ______________________________________________________________________
 
@@ -55,9 +88,15 @@ import (
 
 	"github.com/npillmayer/schuko/tracing"
 	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/dictionary"
 	"github.com/npillmayer/uax/emoji"
+	"github.com/npillmayer/uax/internal/dictrun"
+	"github.com/npillmayer/uax/uax14"
 )
 
+// UnicodeVersion is the UAX#29 version of the word breaking classes.
+const UnicodeVersion = "17.0.0"
+
 // tracer traces to uax.segment .
 func tracer() tracing.Trace {
 	return tracing.Select("uax.segment")
@@ -65,14 +104,11 @@ func tracer() tracing.Trace {
 
 // ClassForRune gets the Unicode #UAX29 word class for a Unicode code-point.
 func ClassForRune(r rune) UAX29Class {
-	if r == rune(0) {
+	if r == uax.EOT {
 		return eot
 	}
-	for c := UAX29Class(0); c <= ZWJClass; c++ {
-		urange := rangeFromUAX29Class[c]
-		if urange != nil && unicode.Is(urange, r) {
-			return c
-		}
+	if c := uax29ClassTable.Lookup(r); c >= 0 {
+		return UAX29Class(c)
 	}
 	return Other
 }
@@ -101,8 +137,10 @@ type WordBreaker struct {
 	longestMatch  int                             // longest active match for any rule of this word breaker
 	penalties     []int                           // returned to the segmenter: penalties to insert
 	weight        int                             // will multiply penalties by this factor
+	profile       PenaltyProfile                  // penalties to emit
 	previousClass UAX29Class                      // class of previously read rune
 	blockedRI     bool                            // are rules for Regional_Indicator currently blocked?
+	dictRun       *dictrun.Run                    // run of SA code-points, if segmented by a dictionary
 }
 
 // NewWordBreaker creates a a new UAX#29 word breaker.
@@ -114,11 +152,15 @@ type WordBreaker struct {
 //   segmenter.Init(...)
 //   for segmenter.Next() ...
 //
-// weight is a multiplying factor for penalties. It must be 0…w…5 and will
-// be capped for values outside this range. Currently this is not used by any test and should probably left to 1.
+// weight is a multiplying factor for penalties. It must be 1…w…5 and will
+// be capped for values outside this range. Clients may provide a penalty
+// profile to replace the default penalties (see DefaultPenalties).
 //
-func NewWordBreaker(weight int) *WordBreaker {
-	gb := &WordBreaker{weight: capw(weight)}
+func NewWordBreaker(weight int, profile ...PenaltyProfile) *WordBreaker {
+	gb := &WordBreaker{weight: capw(weight), profile: DefaultPenalties()}
+	if len(profile) > 0 {
+		gb.profile = profile[0]
+	}
 	gb.publisher = uax.NewRunePublisher()
 	gb.rules = map[UAX29Class][]uax.NfaStateFn{
 		CRClass:                 {rule_NewLine},
@@ -142,6 +184,37 @@ func NewWordBreaker(weight int) *WordBreaker {
 	return gb
 }
 
+// SetDictionary sets a word list to find word boundaries within runs of
+// code-points of line break class SA (Thai, Lao, Khmer, Myanmar, etc.), as
+// recommended by UAX#29 for scripts written without spaces between words.
+// Without a word list, runs of SA code-points are broken between
+// every character (except before combining marks).
+//
+// Runs will be segmented by maximal matching (see dictionary.WordList.Segment).
+// Setting a nil word list switches off the tailoring.
+func (gb *WordBreaker) SetDictionary(words *dictionary.WordList) {
+	if words == nil {
+		gb.dictRun = nil
+		return
+	}
+	uax14.SetupClasses()
+	gb.dictRun = dictrun.New(words)
+}
+
+// SetPenaltyAggregator sets the function to aggregate penalties of rules
+// firing at the same position. The default is uax.AddPenalties.
+func (gb *WordBreaker) SetPenaltyAggregator(pa uax.PenaltyAggregator) {
+	gb.publisher.SetPenaltyAggregator(pa)
+}
+
+// SetRuleObserver sets a callback which is informed about rules being started,
+// accepting or aborting. Setting nil switches reporting off.
+//
+// (Interface uax.RuleObservable)
+func (gb *WordBreaker) SetRuleObserver(obs uax.RuleObserver) {
+	gb.publisher.SetRuleObserver(obs)
+}
+
 // For word breaking we need just a single emoji class.
 // We append it after the last UAX#29 class, which is ZWJ.
 const emojiPictographic UAX29Class = ZWJClass + 1
@@ -202,8 +275,17 @@ func (gb *WordBreaker) ProceedWithRune(r rune, cpClass int) {
 	gb.longestMatch, gb.penalties = gb.publisher.PublishRuneEvent(r, int(c))
 	tracer().P("class", c).Debugf("...done with |match|=%d and p=%v", gb.longestMatch, gb.penalties)
 	gb.previousClass = c
-	setPenalty1(gb, penalty999) //gb.penalties[1] = penalty999, if empty
+	setPenalty1(gb, gb.profile.Default) //gb.penalties[1] = penalty999, if empty
 	//tracer().Debugf("penalites now = %v", gb.penalties)
+	if gb.dictRun != nil {
+		if uax14.ClassForRune(r) == uax14.SAClass {
+			gb.dictRun.Append(r)
+			gb.dictRun.Record(gb.penalties, true)
+		} else if gb.dictRun.Len() > 0 {
+			gb.penalties = gb.dictRun.Close(gb.penalties, gb.profile.Default, gb.profile.ToSuppressBreak)
+		}
+	}
+	scale(gb.penalties, gb.weight)
 }
 
 // LongestActiveMatch collects
@@ -211,7 +293,8 @@ func (gb *WordBreaker) ProceedWithRune(r rune, cpClass int) {
 // and return the longest one for all still active recognizers.
 // (Interface uax.UnicodeBreaker)
 func (gb *WordBreaker) LongestActiveMatch() int {
-	return gb.longestMatch
+	// A run of SA code-points is active until a dictionary has segmented it.
+	return max(gb.longestMatch, gb.dictRun.Len())
 }
 
 // Penalties gets all active penalties for all active recognizers combined.
@@ -222,21 +305,48 @@ func (gb *WordBreaker) Penalties() []int {
 	return gb.penalties
 }
 
-// Penalties (inter-word optional break, suppress break and mandatory break).
-var (
+// Default penalties (inter-word optional break, suppress break and mandatory break).
+const (
 	PenaltyForBreak        = 50
 	PenaltyToSuppressBreak = 10000
 	PenaltyForMustBreak    = -10000
 	penalty999             = 10
 )
 
+// PenaltyProfile is a set of penalties for a WordBreaker or a SentenceBreaker
+// to emit. A profile is handed to a breaker at construction time, thus breakers
+// with different profiles may be used side by side.
+type PenaltyProfile struct {
+	ForBreak        int // optional break, e.g. at the end of a sentence
+	ToSuppressBreak int // suppress a break (×)
+	ForMustBreak    int // mandatory break (!)
+	Default         int // rule WB999: break everywhere else (÷)
+}
+
+// DefaultPenalties returns the penalty profile breakers use if clients do not
+// provide one.
+func DefaultPenalties() PenaltyProfile {
+	return PenaltyProfile{
+		ForBreak:        PenaltyForBreak,
+		ToSuppressBreak: PenaltyToSuppressBreak,
+		ForMustBreak:    PenaltyForMustBreak,
+		Default:         penalty999,
+	}
+}
+
+// wbPenalties returns the penalty profile of the word breaker a recognizer
+// has been started by.
+func wbPenalties(rec *uax.Recognizer) *PenaltyProfile {
+	return &rec.UserData.(*WordBreaker).profile
+}
+
 // --- Rules ------------------------------------------------------------
 
 func rule_NewLine(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX29Class(cpClass)
 	if c == LFClass || c == NewlineClass {
 		//tracer().Debugf("ACCEPT of Rule for Newline")
-		return uax.DoAccept(rec, PenaltyForMustBreak, PenaltyForMustBreak)
+		return uax.DoAccept(rec, wbPenalties(rec).ForMustBreak, wbPenalties(rec).ForMustBreak)
 	} else if c == CRClass {
 		//tracer().Debugf("shift CR")
 		rec.MatchLen++
@@ -249,10 +359,10 @@ func rule_CRLF(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX29Class(cpClass)
 	if c == LFClass {
 		//tracer().Debugf("ACCEPT of Rule for CRLF")
-		return uax.DoAccept(rec, PenaltyForMustBreak, 3*PenaltyToSuppressBreak) // accept CR+LF
+		return uax.DoAccept(rec, wbPenalties(rec).ForMustBreak, 3*wbPenalties(rec).ToSuppressBreak) // accept CR+LF
 	}
 	//tracer().Debugf("ACCEPT of Rule for CR")
-	return uax.DoAccept(rec, 0, PenaltyForMustBreak, PenaltyForMustBreak) // accept CR
+	return uax.DoAccept(rec, 0, wbPenalties(rec).ForMustBreak, wbPenalties(rec).ForMustBreak) // accept CR
 }
 
 func rule_WB3c(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
@@ -262,9 +372,9 @@ func rule_WB3c(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 
 func rule_Pictography(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX29Class(cpClass)
-	if c == emojiPictographic {
+	if c == emojiPictographic || c == ALetterClass && unicode.Is(emoji.Extended_Pictographic, r) {
 		//tracer().Debugf("ACCEPT of Rule for Emoji")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -280,7 +390,7 @@ func finish_WB3d(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	c := UAX29Class(cpClass)
 	if c == WSegSpaceClass {
 		//tracer().Debugf("ACCEPT of Rule WB 3d")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -308,7 +418,7 @@ func finish_WB5_10(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == ALetterClass || c == Hebrew_LetterClass {
 		//tracer().Debugf("ACCEPT of Rule WB 5/10")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -344,8 +454,8 @@ func finish_WB6_7(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	if c == ALetterClass || c == Hebrew_LetterClass {
 		//tracer().Debugf("ACCEPT of Rule WB 6/7")
 		p := make([]int, rec.MatchLen-rec.Expect+1+2)
-		p[len(p)-1] = PenaltyToSuppressBreak
-		p[1] = PenaltyToSuppressBreak
+		p[len(p)-1] = wbPenalties(rec).ToSuppressBreak
+		p[1] = wbPenalties(rec).ToSuppressBreak
 		return uax.DoAccept(rec, p...)
 	}
 	return uax.DoAbort(rec)
@@ -366,7 +476,7 @@ func finish_WB7a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == Single_QuoteClass {
 		//tracer().Debugf("ACCEPT of Rule WB 7 a")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -397,7 +507,7 @@ func finish_WB7bc(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == Hebrew_LetterClass {
 		//tracer().Debugf("ACCEPT of Rule WB 7b,c")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -424,7 +534,7 @@ func finish_WB8_9(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == NumericClass {
 		//tracer().Debugf("ACCEPT of Rule WB 8/9")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -467,8 +577,8 @@ func finish_WB11(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	if c == NumericClass {
 		//tracer().Debugf("ACCEPT of Rule WB 11")
 		p := make([]int, rec.MatchLen-rec.Expect+1+2)
-		p[len(p)-1] = PenaltyToSuppressBreak
-		p[1] = PenaltyToSuppressBreak
+		p[len(p)-1] = wbPenalties(rec).ToSuppressBreak
+		p[1] = wbPenalties(rec).ToSuppressBreak
 		return uax.DoAccept(rec, p...)
 		//return uax.DoAccept(rec, 0, PenaltyToSuppressBreak, PenaltyToSuppressBreak)
 	}
@@ -490,7 +600,7 @@ func finish_WB13(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == KatakanaClass {
 		//tracer().Debugf("ACCEPT of Rule WB 13")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -510,7 +620,7 @@ func finish_WB13a(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == ExtendNumLetClass {
 		//tracer().Debugf("ACCEPT of Rule WB 13 a")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -530,7 +640,7 @@ func finish_WB13b(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	}
 	if c == ALetterClass || c == Hebrew_LetterClass || c == NumericClass || c == KatakanaClass {
 		//tracer().Debugf("ACCEPT of Rule WB 13 b")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -554,7 +664,7 @@ func finish_WB15(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 	gb.unblock(Regional_IndicatorClass)
 	if c == Regional_IndicatorClass {
 		//tracer().Debugf("ACCEPT of Rule WB 15")
-		return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+		return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 	}
 	return uax.DoAbort(rec)
 }
@@ -569,7 +679,7 @@ func rule_WB4(rec *uax.Recognizer, r rune, cpClass int) uax.NfaStateFn {
 		prev := gb.previousClass
 		if prev != LFClass && prev != NewlineClass && prev != CRClass {
 			//tracer().Debugf("ACCEPT of Rule WB 4")
-			return uax.DoAccept(rec, 0, PenaltyToSuppressBreak)
+			return uax.DoAccept(rec, 0, wbPenalties(rec).ToSuppressBreak)
 		}
 	}
 	return uax.DoAbort(rec)
@@ -600,11 +710,21 @@ func extendFormatZWJ(c UAX29Class) bool {
 }
 
 func capw(w int) int {
-	if w < 0 {
-		return w
+	if w < 1 {
+		return 1
 	}
 	if w > 5 {
 		return 5
 	}
 	return w
 }
+
+// scale multiplies penalties by a breaker's weight.
+func scale(penalties []int, w int) {
+	if w == 1 {
+		return
+	}
+	for i := range penalties {
+		penalties[i] *= w
+	}
+}
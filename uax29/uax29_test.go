@@ -1,12 +1,16 @@
 package uax29_test
 
 import (
+	"bufio"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
 	"github.com/npillmayer/schuko/tracing"
 	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/dictionary"
 	"github.com/npillmayer/uax/internal/ucdparse"
 	"github.com/npillmayer/uax/segment"
 	"github.com/npillmayer/uax/uax29"
@@ -26,6 +30,30 @@ func ExampleWordBreaker() {
 	// '!'
 }
 
+func ExampleScanWords() {
+	scanner := bufio.NewScanner(strings.NewReader("Hello World🇩🇪!"))
+	scanner.Split(uax29.ScanWords)
+	for scanner.Scan() {
+		fmt.Printf("'%s'\n", scanner.Text())
+	}
+	// Output: 'Hello'
+	// ' '
+	// 'World'
+	// '🇩🇪'
+	// '!'
+}
+
+func ExampleWords() {
+	for w := range uax29.Words("Hello World🇩🇪!") {
+		fmt.Printf("'%s'\n", w)
+	}
+	// Output: 'Hello'
+	// ' '
+	// 'World'
+	// '🇩🇪'
+	// '!'
+}
+
 func TestWordBreaks1(t *testing.T) {
 	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
 	defer teardown()
@@ -62,6 +90,18 @@ func TestWordBreaks2(t *testing.T) {
 	//t.Fail()
 }
 
+func TestUnicodeVersion(t *testing.T) {
+	f, err := os.Open("./WordBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	header, _ := bufio.NewReader(f).ReadString('\n')
+	if expected := "# WordBreakTest-" + uax29.UnicodeVersion + ".txt"; strings.TrimSpace(header) != expected {
+		t.Errorf("expected test file %q for UnicodeVersion, have %q", expected, strings.TrimSpace(header))
+	}
+}
+
 func TestWordBreakTestFile(t *testing.T) {
 	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
 	defer teardown()
@@ -72,7 +112,7 @@ func TestWordBreakTestFile(t *testing.T) {
 	//seg.BreakOnZero(true, false)
 	tf := ucdparse.OpenTestFile("./WordBreakTest.txt", t)
 	defer tf.Close()
-	failcnt, i, from, to := 0, 0, 1, 1900
+	failcnt, i, from, to := 0, 0, 1, 2000
 	for tf.Scan() {
 		i++
 		if i >= from {
@@ -106,5 +146,115 @@ func executeSingleTest(t *testing.T, seg *segment.Segmenter, tno int, in string,
 		}
 		i++
 	}
+	if i < len(out) {
+		t.Errorf("test #%d: number of segments too small: %d < %d", tno, i, len(out))
+		ok = false
+	}
 	return ok
 }
+
+func TestWordBreakerDictionary(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	onWords := uax29.NewWordBreaker(1)
+	onWords.SetDictionary(dictionary.NewWordList("ฉัน", "ไป", "โรงเรียน", "โรง", "เรียน"))
+	segmenter := segment.NewSegmenter(onWords)
+	segmenter.InitFromString("ฉันไปโรงเรียน, OK")
+	var words []string
+	for segmenter.Next() {
+		words = append(words, segmenter.Text())
+	}
+	expected := []string{"ฉัน", "ไป", "โรงเรียน", ",", " ", "OK"}
+	if strings.Join(words, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected words %q, have %q", expected, words)
+	}
+}
+
+func TestWordTypes(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	expected := []string{"Don't/Letter", " /Space", "pay/Letter", " /Space", "3.50/Number",
+		"€/None", " /Space", "for/Letter", " /Space", "A4/Letter", "\t/Space", "カタカナ/Kana",
+		",/Punctuation", " /Space", "漢/Ideographic", "字/Ideographic", " /Space", "ひ/Kana",
+		" /Space", "🇩🇪/Emoji", "👍🏽/Emoji", "!/Punctuation"}
+	for _, breaker := range []uax.UnicodeBreaker{uax29.NewWordBreaker(1), uax29.NewFastWordBreaker(1)} {
+		segmenter := segment.NewSegmenter(breaker)
+		segmenter.InitFromString("Don't pay 3.50€ for A4\tカタカナ, 漢字 ひ 🇩🇪👍🏽!")
+		var words []string
+		letters := 0
+		for segmenter.Next() {
+			words = append(words, segmenter.Text()+"/"+uax29.TypeOf(segmenter).String())
+			if segmenter.WordType() == uax29.Letter {
+				letters++
+			}
+		}
+		if strings.Join(words, "|") != strings.Join(expected, "|") {
+			t.Errorf("expected word types %q, have %q", expected, words)
+		}
+		if letters != 4 {
+			t.Errorf("expected 4 words of letters, have %d", letters)
+		}
+	}
+	if segmenter := segment.NewSegmenter(); segmenter.WordType() != uax29.None {
+		t.Errorf("expected word type None for a breaker not reporting word types")
+	}
+}
+
+func TestWordBreakerPenalties(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	custom := uax29.DefaultPenalties()
+	custom.Default = 7
+	for i, test := range []struct {
+		breaker   *uax29.WordBreaker
+		penalties string
+	}{
+		{uax29.NewWordBreaker(1), "Hello/10| /10|World/10|./-10000|\n/-10000|x/10"},
+		{uax29.NewWordBreaker(2), "Hello/20| /20|World/20|./-20000|\n/-20000|x/20"},
+		{uax29.NewWordBreaker(3, custom), "Hello/21| /21|World/21|./-30000|\n/-30000|x/21"},
+	} {
+		segmenter := segment.NewSegmenter(test.breaker)
+		segmenter.InitFromString("Hello World.\nx")
+		var segments []string
+		for segmenter.Next() {
+			p0, _ := segmenter.Penalties()
+			segments = append(segments, fmt.Sprintf("%s/%d", segmenter.Text(), p0))
+		}
+		if s := strings.Join(segments, "|"); s != test.penalties {
+			t.Errorf("test #%d: expected %q, have %q", i, test.penalties, s)
+		}
+	}
+}
+
+func TestWordBreakerPenaltyAggregator(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	for i, test := range []struct {
+		aggregate uax.PenaltyAggregator
+		words     string
+	}{
+		{nil, "a|\r\n|b"},
+		{uax.MaxPenalties, "a|\r\n|b"},
+		{uax.MinPenalties, "a|\r|\n|b"}, // rule for LF overrides CR × LF
+	} {
+		onWords := uax29.NewWordBreaker(1)
+		onWords.SetPenaltyAggregator(test.aggregate)
+		segmenter := segment.NewSegmenter(onWords)
+		segmenter.InitFromString("a\r\nb")
+		var words []string
+		for segmenter.Next() {
+			words = append(words, segmenter.Text())
+		}
+		if s := strings.Join(words, "|"); s != test.words {
+			t.Errorf("test #%d: expected %q, have %q", i, test.words, s)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package uax29
+
+// This file has been generated -- you probably should NOT EDIT IT !
+// Compiled from the rules of WordBreaker by uax29/internal/dfagenerator.
+
+import "github.com/npillmayer/uax/internal/dfa"
+
+// wordDFA is a deterministic automaton with 28 states over 22 input symbols.
+var wordDFA = &dfa.Table{
+	Symbols: 22,
+	EOT:     20,
+	Next: []uint16{
+		1, 2, 3, 3, 4, 3, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 1, 4, 1, 5, 6, 7, 12, 3, 12, 7, 8, 9, 12, 10, 13, 3, 3, 0, 1,
+		1, 2, 3, 3, 4, 3, 5, 6, 14, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 3, 4, 3, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 4, 4, 4, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 15, 3, 3, 0, 1,
+		1, 2, 16, 5, 4, 5, 5, 6, 7, 12, 3, 12, 7, 8, 9, 17, 10, 18, 3, 3, 0, 1,
+		1, 2, 3, 6, 4, 6, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 19, 3, 3, 0, 1,
+		1, 2, 3, 3, 4, 3, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 8, 4, 8, 5, 6, 7, 3, 20, 20, 7, 8, 9, 20, 10, 21, 3, 3, 0, 1,
+		1, 2, 3, 9, 4, 9, 5, 6, 7, 3, 3, 3, 7, 8, 3, 3, 10, 22, 3, 3, 0, 1,
+		1, 2, 3, 3, 4, 3, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 3, 4, 3, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 12, 4, 12, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 23, 3, 3, 0, 1,
+		1, 2, 3, 1, 4, 1, 5, 6, 7, 12, 3, 12, 7, 8, 9, 12, 10, 13, 3, 3, 0, 1,
+		1, 2, 3, 3, 4, 3, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 11, 3, 3, 0, 1,
+		1, 2, 3, 4, 4, 4, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 15, 3, 3, 0, 1,
+		1, 2, 3, 24, 4, 24, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 25, 3, 3, 0, 1,
+		1, 2, 3, 17, 4, 17, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 26, 3, 3, 0, 1,
+		1, 2, 16, 5, 4, 5, 5, 6, 7, 12, 3, 12, 7, 8, 9, 17, 10, 18, 3, 3, 0, 1,
+		1, 2, 3, 6, 4, 6, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 19, 3, 3, 0, 1,
+		1, 2, 3, 20, 4, 20, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 27, 3, 3, 0, 1,
+		1, 2, 3, 8, 4, 8, 5, 6, 7, 3, 20, 20, 7, 8, 9, 20, 10, 21, 3, 3, 0, 1,
+		1, 2, 3, 9, 4, 9, 5, 6, 7, 3, 3, 3, 7, 8, 3, 3, 10, 22, 3, 3, 0, 1,
+		1, 2, 3, 12, 4, 12, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 23, 3, 3, 0, 1,
+		1, 2, 3, 24, 4, 24, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 25, 3, 3, 0, 1,
+		1, 2, 3, 24, 4, 24, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 25, 3, 3, 0, 1,
+		1, 2, 3, 17, 4, 17, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 26, 3, 3, 0, 1,
+		1, 2, 3, 20, 4, 20, 5, 6, 7, 3, 3, 3, 7, 8, 9, 3, 10, 27, 3, 3, 0, 1,
+	},
+	Before: []uint8{
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		2, 3, 3, 2, 2, 2, 2, 3, 4, 0, 3, 0, 4, 2, 3, 0, 3, 2, 3, 3, 3, 2,
+		4, 4, 4, 4, 4, 4, 4, 4, 5, 4, 4, 4, 6, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 3, 3, 3, 3,
+		2, 3, 3, 2, 2, 2, 2, 2, 4, 3, 3, 3, 4, 2, 3, 3, 3, 2, 3, 3, 3, 2,
+		2, 3, 0, 2, 2, 2, 2, 3, 4, 0, 3, 0, 4, 2, 3, 0, 3, 2, 3, 3, 3, 2,
+		3, 3, 3, 2, 2, 2, 3, 2, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 3, 3, 3, 3,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		2, 3, 3, 2, 2, 2, 2, 3, 4, 3, 0, 0, 4, 2, 3, 0, 3, 2, 3, 3, 3, 2,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 3, 2, 3, 3, 2, 3, 3, 3, 3,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 3, 3, 3, 2, 2, 3, 3, 3, 3,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 2, 3, 3, 2,
+		2, 3, 3, 2, 3, 2, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 3, 3, 3, 2,
+		2, 3, 3, 2, 2, 2, 2, 3, 4, 0, 3, 0, 4, 2, 3, 0, 3, 2, 2, 3, 3, 5,
+		6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+		2, 3, 3, 2, 2, 2, 2, 2, 4, 3, 3, 3, 4, 2, 3, 3, 3, 2, 2, 3, 3, 5,
+		3, 3, 3, 0, 3, 0, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 0, 3, 3, 3, 3,
+		2, 3, 3, 2, 3, 2, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 3, 3, 3, 2,
+		2, 3, 0, 2, 2, 2, 2, 3, 4, 0, 3, 0, 4, 2, 3, 0, 3, 2, 2, 3, 3, 5,
+		3, 3, 3, 2, 2, 2, 3, 2, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 2, 3, 3, 2,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 2, 3, 3, 3, 2, 3, 3, 3, 3,
+		2, 3, 3, 2, 2, 2, 2, 3, 4, 3, 0, 0, 4, 2, 3, 0, 3, 2, 2, 3, 3, 5,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 3, 2, 3, 3, 2, 2, 3, 3, 2,
+		2, 3, 3, 2, 3, 2, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 2, 3, 3, 5,
+		3, 3, 3, 0, 3, 0, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 0, 3, 3, 3, 3,
+		3, 3, 3, 0, 3, 0, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 0, 2, 3, 3, 2,
+		2, 3, 3, 2, 3, 2, 2, 3, 4, 3, 3, 3, 4, 3, 3, 3, 3, 2, 2, 3, 3, 5,
+		3, 3, 3, 2, 3, 2, 3, 3, 4, 3, 3, 3, 4, 2, 3, 3, 3, 2, 2, 3, 3, 2,
+	},
+	Resolve: []uint8{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		7, 3, 3, 0, 3, 0, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 0, 3, 3, 3, 7,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		3, 3, 3, 3, 3, 3, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+		5, 2, 2, 0, 2, 0, 5, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 0, 2, 2, 2, 5,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		3, 3, 3, 0, 3, 0, 3, 3, 3, 3, 3, 3, 3, 7, 3, 3, 3, 0, 3, 3, 3, 3,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		7, 3, 3, 0, 3, 0, 7, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 0, 3, 3, 3, 7,
+		2, 2, 2, 2, 2, 2, 5, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		2, 2, 2, 2, 2, 2, 5, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+		5, 2, 2, 0, 2, 0, 5, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 0, 2, 2, 2, 5,
+		3, 3, 3, 0, 3, 0, 3, 3, 3, 3, 3, 3, 3, 7, 3, 3, 3, 0, 3, 3, 3, 3,
+	},
+	Values: []int{
+		0, 10000, 10, -10000, 20000, -20000, 10010,
+	},
+}
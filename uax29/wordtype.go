@@ -0,0 +1,95 @@
+package uax29
+
+import (
+	"strconv"
+	"unicode"
+
+	"github.com/npillmayer/uax/segment"
+)
+
+// WordType is the type of a word segment, as reported by Segmenter.WordType()
+// for a WordBreaker as the primary breaker. The constants for the types are
+// untyped, thus they compare to the result of Segmenter.WordType() without a
+// conversion:
+//
+//   for segmenter.Next() {
+//       if segmenter.WordType() == uax29.Letter { ... }
+//   }
+//
+// Segments consisting of code-points of more than one type get the type
+// listed last, e.g. "A4" is a Letter segment.
+type WordType int
+
+// Types of word segments
+const (
+	None        = iota // segment of other code-points, e.g. symbols or control characters
+	Space              // white space and newlines
+	Punctuation        // punctuation, including word-internal punctuation standing alone
+	Emoji              // pictographs and regional indicator flags
+	Number             // digits and numbers, like "3.141"
+	Letter             // letters, like "Hello" or "can't"
+	Kana               // Katakana and Hiragana
+	Ideographic        // ideographic characters
+)
+
+var wordTypeNames = [...]string{"None", "Space", "Punctuation", "Emoji", "Number",
+	"Letter", "Kana", "Ideographic"}
+
+func (t WordType) String() string {
+	if t < 0 || int(t) >= len(wordTypeNames) {
+		return "WordType(" + strconv.Itoa(int(t)) + ")"
+	}
+	return wordTypeNames[t]
+}
+
+// CodePointType returns the type of a code-point (see type WordType), derived
+// from its UAX#29 class. The segmenter records it for every code-point read,
+// and the type of a segment is the greatest type of its code-points.
+// Code-points of classes Extend, Format and ZWJ are of type None and
+// therefore do not contribute to the type of a segment.
+//
+// (Interface uax.WordTyper)
+func (gb *WordBreaker) CodePointType(r rune, cpClass int) int {
+	return wordTypeOf(r, UAX29Class(cpClass))
+}
+
+// TypeOf returns the type of the most recent segment of a segmenter with a
+// word breaker as its primary breaker. It is the typed version of
+// segmenter.WordType().
+func TypeOf(segmenter *segment.Segmenter) WordType {
+	return WordType(segmenter.WordType())
+}
+
+// wordTypeOf classifies a single code-point of UAX#29 class c. Code-points of
+// class Other are further discriminated by their general category and script.
+func wordTypeOf(r rune, c UAX29Class) int {
+	switch c {
+	case ALetterClass, Hebrew_LetterClass:
+		return Letter
+	case KatakanaClass:
+		return Kana
+	case NumericClass:
+		return Number
+	case WSegSpaceClass, CRClass, LFClass, NewlineClass:
+		return Space
+	case Regional_IndicatorClass, emojiPictographic:
+		return Emoji
+	case MidLetterClass, MidNumClass, MidNumLetClass, Single_QuoteClass,
+		Double_QuoteClass, ExtendNumLetClass:
+		return Punctuation
+	case Other:
+		switch {
+		case unicode.Is(unicode.Ideographic, r):
+			return Ideographic
+		case unicode.Is(unicode.Hiragana, r):
+			return Kana
+		case unicode.IsLetter(r): // e.g., letters of SA scripts like Thai
+			return Letter
+		case unicode.IsSpace(r):
+			return Space
+		case unicode.IsPunct(r):
+			return Punctuation
+		}
+	}
+	return None
+}
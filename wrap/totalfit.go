@@ -0,0 +1,384 @@
+package wrap
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax14"
+)
+
+// === Total-Fit Line Breaking ===================================================
+
+// The total-fit algorithm by D.E. Knuth and M.F. Plass considers all the break
+// opportunities of a paragraph at once and selects the set of breaks with the
+// least total demerits. It is the line breaking algorithm of TeX, and the penalty
+// model of this module follows TeX's tradition: uax.InfinitePenalty suppresses a
+// break and uax.InfiniteMerits forces one.
+//
+// We model a paragraph as a sequence of fragments between break opportunities,
+// as delivered by a segmenter with a UAX#14 line breaker. Every fragment consists
+// of a box (the text) followed by glue (trailing whitespace) and a penalty (the
+// break opportunity). Glue at the end of a line is discarded.
+//
+// Different from TeX, merits (negative penalties) do not reduce demerits. UAX#14
+// assigns a merit to almost every break after spaces (rule LB18). Subtracting it
+// for every line would favour paragraphs with more lines.
+//
+// References:
+//
+// Donald E. Knuth and Michael F. Plass: Breaking Paragraphs into Lines.
+// Software—Practice and Experience 11 (1981), 1119–1184.
+
+// Measure supplies the dimensions of boxes and glue to the total-fit line breaker.
+// Dimensions may be given in any unit, e.g. PDF points, as long as the line width
+// uses the same unit.
+type Measure interface {
+	BoxWidth(text string) float64                       // width of a run of text, without trailing whitespace
+	Glue(space string) (width, stretch, shrink float64) // dimensions of a run of whitespace
+	HyphenWidth() float64                               // width of a hyphen inserted at a hyphenation break
+}
+
+// Parameters control the total-fit line breaker. The names follow the parameters
+// of TeX.
+type Parameters struct {
+	Tolerance       float64 // maximum adjustment ratio of a line
+	LinePenalty     float64 // added to the badness of every line (TeX: \linepenalty)
+	FlaggedDemerits float64 // for consecutive hyphenated lines (TeX: \doublehyphendemerits)
+	FitnessDemerits float64 // for adjacent lines of incompatible fitness classes (TeX: \adjdemerits)
+	Looseness       int     // try to make the paragraph this many lines longer or shorter (TeX: \looseness)
+}
+
+// DefaultParameters returns parameters with the default values of plain TeX,
+// except for the tolerance, which is given as an adjustment ratio.
+func DefaultParameters() *Parameters {
+	return &Parameters{
+		Tolerance:       2,
+		LinePenalty:     10,
+		FlaggedDemerits: 10000,
+		FitnessDemerits: 10000,
+	}
+}
+
+// FitnessClass classifies lines by their adjustment ratio. Adjacent lines with
+// fitness classes differing by more than one look inhomogeneous and receive
+// additional demerits.
+type FitnessClass int
+
+// Fitness classes, as defined by Knuth and Plass.
+const (
+	Tight     FitnessClass = iota // adjustment ratio < -0.5
+	Decent                        // -0.5 ≤ adjustment ratio ≤ 0.5
+	Loose                         // 0.5 < adjustment ratio ≤ 1
+	VeryLoose                     // adjustment ratio > 1
+)
+
+func (f FitnessClass) String() string {
+	switch f {
+	case Tight:
+		return "tight"
+	case Decent:
+		return "decent"
+	case Loose:
+		return "loose"
+	case VeryLoose:
+		return "very loose"
+	}
+	return "?"
+}
+
+func fitnessFor(r float64) FitnessClass {
+	switch {
+	case r < -0.5:
+		return Tight
+	case r <= 0.5:
+		return Decent
+	case r <= 1:
+		return Loose
+	}
+	return VeryLoose
+}
+
+// Breakpoint is a break selected by the total-fit line breaker. It describes the
+// line ending at the break.
+type Breakpoint struct {
+	Position   int          // byte offset of the break in the input of the segmenter
+	Line       int          // number of the line ending here, starting at 1
+	Penalty    int          // penalty of the break opportunity
+	Ratio      float64      // adjustment ratio of the line
+	Fitness    FitnessClass // fitness class of the line
+	Demerits   float64      // total demerits of the paragraph up to this break
+	Hyphenated bool         // a hyphen has to be inserted at the break
+}
+
+// Paragraph is the result of the total-fit line breaker.
+type Paragraph struct {
+	Breakpoints []Breakpoint // one breakpoint per line, the last one at the end of the input
+	Demerits    float64      // total demerits of the paragraph
+	Looseness   int          // number of lines more (or less) than for the optimal solution
+}
+
+// ErrNoInput is returned by TotalFit if the segmenter does not deliver any segments.
+var ErrNoInput = errors.New("total-fit: no input to break into lines")
+
+// ErrNoSolution is returned by TotalFit if not even the second pass finds a way to
+// break the paragraph into lines.
+var ErrNoSolution = errors.New("total-fit: no feasible breakpoints")
+
+// TotalFit finds the optimal breakpoints for a paragraph. seg has to be initialized
+// with the text of the paragraph and a uax14.LineWrap as its primary breaker.
+// Secondary breakers may add break opportunities. A break opportunity which
+// is not found by the primary breaker, but only by secondary breakers, is
+// considered a hyphenation point, as is a break after a soft hyphen (U+00AD).
+// Measure.BoxWidth should treat soft hyphens as having zero width.
+//
+// Lines will be broken to a width of lineWidth. If params is nil, DefaultParameters()
+// is used. If no solution within params.Tolerance exists, a second pass will allow
+// lines of any looseness and, as a last resort, overfull lines consisting of a
+// single fragment.
+func TotalFit(seg *segment.Segmenter, measure Measure, lineWidth float64, params *Parameters) (*Paragraph, error) {
+	if params == nil {
+		params = DefaultParameters()
+	}
+	items := collectItems(seg, measure)
+	if err := seg.Err(); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ErrNoInput
+	}
+	kp := &totalFit{items: items, params: params, lineWidth: lineWidth}
+	kp.sumUp()
+	if active := kp.run(params.Tolerance, false); active != nil {
+		return kp.paragraph(active), nil
+	}
+	tracer().Infof("total-fit: no solution within tolerance, second pass")
+	active := kp.run(math.Inf(1), true)
+	if active == nil {
+		return nil, ErrNoSolution
+	}
+	return kp.paragraph(active), nil
+}
+
+// item is a fragment between two break opportunities.
+type item struct {
+	end                   int     // byte offset of the break after the fragment
+	box                   float64 // width of the text
+	glue, stretch, shrink float64 // dimensions of trailing whitespace
+	hyphen                float64 // width of a hyphen to insert when breaking here
+	penalty               int     // penalty for breaking here
+	hyphenated            bool    // a hyphen has to be inserted when breaking here
+	flagged               bool    // break is after a hyphen or a hyphenation
+	forced                bool    // break is mandatory
+}
+
+func collectItems(seg *segment.Segmenter, measure Measure) []item {
+	var items []item
+	for seg.Next() {
+		text := seg.Text()
+		_, end := seg.ByteOffsets()
+		p0, p1 := seg.Penalties()
+		content := strings.TrimRightFunc(text, unicode.IsSpace)
+		it := item{end: end, box: measure.BoxWidth(content)}
+		if space := text[len(content):]; space != "" {
+			it.glue, it.stretch, it.shrink = measure.Glue(space)
+		}
+		last, _ := utf8.DecodeLastRuneInString(content)
+		secondary := p1 != 0 && p1 < uax.InfinitePenalty // a secondary breaker votes for a break
+		if secondary && (p0 >= uax.InfinitePenalty || p0 == 0) { // break by secondary breaker only
+			it.penalty = p1
+			it.hyphenated = len(content) == len(text) && !isHyphen(last)
+		} else {
+			it.penalty = p0 + p1
+			it.hyphenated = last == softHyphen
+		}
+		it.flagged = it.hyphenated || isHyphen(last)
+		if it.hyphenated {
+			it.hyphen = measure.HyphenWidth()
+		}
+		it.forced = it.penalty <= uax.InfiniteMerits || endsWithHardBreak(text)
+		items = append(items, it)
+	}
+	if len(items) > 0 {
+		items[len(items)-1].forced = true // end of paragraph
+	}
+	return items
+}
+
+// node is a feasible breakpoint in the graph of breaks.
+type node struct {
+	pos      int // index of the item after which to break, -1 for the start
+	line     int
+	fitness  FitnessClass
+	ratio    float64
+	demerits float64
+	prev     *node
+}
+
+type totalFit struct {
+	items     []item
+	params    *Parameters
+	lineWidth float64
+	width     []float64 // cumulative widths of boxes and glue, up to and including item i-1
+	stretch   []float64 // cumulative stretch
+	shrink    []float64 // cumulative shrink
+}
+
+func (kp *totalFit) sumUp() {
+	n := len(kp.items)
+	kp.width = make([]float64, n+1)
+	kp.stretch = make([]float64, n+1)
+	kp.shrink = make([]float64, n+1)
+	for i, it := range kp.items {
+		kp.width[i+1] = kp.width[i] + it.box + it.glue
+		kp.stretch[i+1] = kp.stretch[i] + it.stretch
+		kp.shrink[i+1] = kp.shrink[i] + it.shrink
+	}
+}
+
+// ratio calculates the adjustment ratio of a line from the break after item a to
+// the break after item b.
+func (kp *totalFit) ratio(a, b int) float64 {
+	it := kp.items[b]
+	natural := kp.width[b+1] - kp.width[a+1] - it.glue + it.hyphen
+	if natural == kp.lineWidth {
+		return 0
+	}
+	if natural < kp.lineWidth {
+		if it.forced { // the last line of a paragraph is filled with glue
+			return 0
+		}
+		stretch := kp.stretch[b+1] - kp.stretch[a+1] - it.stretch
+		if stretch <= 0 {
+			return math.Inf(1)
+		}
+		return (kp.lineWidth - natural) / stretch
+	}
+	shrink := kp.shrink[b+1] - kp.shrink[a+1] - it.shrink
+	if shrink <= 0 {
+		return math.Inf(-1)
+	}
+	return (kp.lineWidth - natural) / shrink
+}
+
+// demerits calculates the demerits for a line ending at item b with adjustment ratio r.
+func (kp *totalFit) demerits(from *node, b int, r float64, fitness FitnessClass) float64 {
+	badness := 100 * math.Pow(math.Abs(r), 3)
+	if badness > uax.InfinitePenalty || math.IsInf(r, 0) {
+		badness = uax.InfinitePenalty
+	}
+	d := math.Pow(kp.params.LinePenalty+badness, 2)
+	it := kp.items[b]
+	if p := float64(it.penalty); !it.forced && p > 0 { // forced breaks are the same for every solution
+		d += p * p
+	}
+	if it.flagged && from.pos >= 0 && kp.items[from.pos].flagged {
+		d += kp.params.FlaggedDemerits
+	}
+	if from.pos >= 0 && (fitness-from.fitness > 1 || from.fitness-fitness > 1) {
+		d += kp.params.FitnessDemerits
+	}
+	return d
+}
+
+// run is a pass of the total-fit algorithm. It returns the active nodes at the end
+// of the paragraph, or nil if no solution has been found.
+//
+// In an emergency pass, a line from one break opportunity to the next one is
+// accepted even if it is overfull. Items with a suppressed break are no break
+// opportunities and are skipped.
+func (kp *totalFit) run(tolerance float64, emergency bool) []*node {
+	active := []*node{{pos: -1, fitness: Decent}}
+	prev := -1 // previous break opportunity
+	for b, it := range kp.items {
+		if it.penalty >= uax.InfinitePenalty && !it.forced {
+			continue
+		}
+		candidates := make(map[[2]int]*node) // best candidate per line number and fitness class
+		survivors := active[:0]
+		for _, a := range active {
+			r := kp.ratio(a.pos, b)
+			if r >= -1 && r <= tolerance ||
+				emergency && r < -1 && a.pos == prev { // overfull line, last resort
+				f := fitnessFor(r)
+				d := a.demerits + kp.demerits(a, b, r, f)
+				key := [2]int{a.line + 1, int(f)}
+				if c := candidates[key]; c == nil || d < c.demerits {
+					candidates[key] = &node{pos: b, line: a.line + 1, fitness: f, ratio: r, demerits: d, prev: a}
+				}
+			}
+			if r >= -1 && !it.forced {
+				survivors = append(survivors, a)
+			}
+		}
+		active = survivors
+		for _, c := range candidates {
+			active = append(active, c)
+		}
+		if len(active) == 0 {
+			return nil
+		}
+		prev = b
+	}
+	return active
+}
+
+// choose selects the final node, respecting the looseness parameter. It returns
+// the node and the difference in lines to the optimal solution.
+func (kp *totalFit) choose(active []*node) (*node, int) {
+	var best *node
+	for _, a := range active {
+		if best == nil || a.demerits < best.demerits {
+			best = a
+		}
+	}
+	target := best.line + kp.params.Looseness
+	chosen := best
+	for _, a := range active {
+		da, dc := abs(a.line-target), abs(chosen.line-target)
+		if da < dc || da == dc && a.demerits < chosen.demerits {
+			chosen = a
+		}
+	}
+	return chosen, chosen.line - best.line
+}
+
+// paragraph collects the breakpoints of the solution chosen from the final active nodes.
+func (kp *totalFit) paragraph(active []*node) *Paragraph {
+	last, looseness := kp.choose(active)
+	par := &Paragraph{Demerits: last.demerits, Looseness: looseness}
+	for n := last; n.pos >= 0; n = n.prev {
+		it := kp.items[n.pos]
+		par.Breakpoints = append(par.Breakpoints, Breakpoint{
+			Position:   it.end,
+			Line:       n.line,
+			Penalty:    it.penalty,
+			Ratio:      n.ratio,
+			Fitness:    n.fitness,
+			Demerits:   n.demerits,
+			Hyphenated: it.hyphenated,
+		})
+	}
+	for i, j := 0, len(par.Breakpoints)-1; i < j; i, j = i+1, j-1 {
+		par.Breakpoints[i], par.Breakpoints[j] = par.Breakpoints[j], par.Breakpoints[i]
+	}
+	return par
+}
+
+const softHyphen = '\u00AD'
+
+// isHyphen checks if a rune is a visible hyphen or a soft hyphen.
+func isHyphen(r rune) bool {
+	return r == softHyphen || uax14.ClassForRune(r) == uax14.HYClass
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,206 @@
+package wrap_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax14"
+	"github.com/npillmayer/uax/wrap"
+)
+
+// monospace measures text in characters. Spaces may stretch by 1/2 and shrink by 1/3.
+type monospace struct{}
+
+func (monospace) BoxWidth(text string) float64 {
+	return float64(utf8.RuneCountInString(strings.ReplaceAll(text, "­", "")))
+}
+
+func (monospace) Glue(space string) (float64, float64, float64) {
+	n := float64(utf8.RuneCountInString(space))
+	return n, n / 2, n / 3
+}
+
+func (monospace) HyphenWidth() float64 {
+	return 1
+}
+
+func totalFit(t *testing.T, text string, width float64, params *wrap.Parameters) (*wrap.Paragraph, []string) {
+	seg := segment.NewSegmenter(uax14.NewLineWrap())
+	seg.InitFromString(text)
+	par, err := wrap.TotalFit(seg, monospace{}, width, params)
+	if err != nil {
+		t.Fatalf("total-fit failed: %v", err)
+	}
+	var lines []string
+	start := 0
+	for _, b := range par.Breakpoints {
+		line := strings.TrimSpace(text[start:b.Position])
+		if b.Hyphenated {
+			line += "-"
+		}
+		lines = append(lines, line)
+		start = b.Position
+	}
+	return par, lines
+}
+
+var knuthPlassText = `In olden times when wishing still helped one, there lived a king whose daughters were all beautiful; and the youngest was so beautiful that the sun itself, which has seen so much, was astonished whenever it shone in her face.`
+
+func ExampleTotalFit() {
+	text := "In olden times when wishing still helped one, there lived a king whose daughters were all beautiful."
+	seg := segment.NewSegmenter(uax14.NewLineWrap())
+	seg.InitFromString(text)
+	par, _ := wrap.TotalFit(seg, monospace{}, 28, nil)
+	start := 0
+	for _, b := range par.Breakpoints {
+		fmt.Printf("%-30s| %s\n", strings.TrimSpace(text[start:b.Position]), b.Fitness)
+		start = b.Position
+	}
+	// Output: In olden times when wishing   | decent
+	// still helped one, there lived | tight
+	// a king whose daughters were   | decent
+	// all beautiful.                | decent
+}
+
+func TestTotalFitLines(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	par, lines := totalFit(t, knuthPlassText, 40, nil)
+	t.Logf("\n%s", strings.Join(lines, "\n"))
+	if par.Breakpoints[len(par.Breakpoints)-1].Position != len(knuthPlassText) {
+		t.Errorf("expected last breakpoint at end of text")
+	}
+	for i, b := range par.Breakpoints {
+		if b.Line != i+1 {
+			t.Errorf("expected breakpoint #%d to end line %d, is %d", i, i+1, b.Line)
+		}
+		if b.Ratio < -1 || b.Ratio > 2 {
+			t.Errorf("line %d has adjustment ratio %.2f", b.Line, b.Ratio)
+		}
+		if i > 0 && b.Demerits < par.Breakpoints[i-1].Demerits {
+			t.Errorf("demerits are expected to accumulate")
+		}
+	}
+	// a greedy algorithm needs as many lines, but leaves lines with more whitespace
+	greedy := wrap.Text(knuthPlassText, 40, func(s string) int { return utf8.RuneCountInString(s) })
+	if len(greedy) != len(lines) {
+		t.Errorf("greedy algorithm finds %d lines, total-fit finds %d", len(greedy), len(lines))
+	}
+	if maxRagged(lines[:len(lines)-1], 40) > maxRagged(greedy[:len(greedy)-1], 40) {
+		t.Errorf("expected total-fit to be at least as balanced as greedy line breaking")
+	}
+}
+
+// maxRagged returns the largest amount of space missing from any line.
+func maxRagged(lines []string, width int) int {
+	m := 0
+	for _, l := range lines {
+		if d := width - utf8.RuneCountInString(l); d > m {
+			m = d
+		}
+	}
+	return m
+}
+
+func TestTotalFitLooseness(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	optimal, _ := totalFit(t, knuthPlassText, 40, nil)
+	params := wrap.DefaultParameters()
+	params.Looseness = 1
+	params.Tolerance = 10
+	loose, lines := totalFit(t, knuthPlassText, 40, params)
+	t.Logf("\n%s", strings.Join(lines, "\n"))
+	if len(loose.Breakpoints) != len(optimal.Breakpoints)+1 || loose.Looseness != 1 {
+		t.Errorf("expected a paragraph with one line more than %d, have %d (looseness %d)",
+			len(optimal.Breakpoints), len(loose.Breakpoints), loose.Looseness)
+	}
+	if loose.Demerits < optimal.Demerits {
+		t.Errorf("expected a loose paragraph to have more demerits than the optimal one")
+	}
+}
+
+func TestTotalFitForcedBreaks(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	_, lines := totalFit(t, "Hello\nWorld, how are you today?", 14, nil)
+	if strings.Join(lines, "|") != "Hello|World, how are|you today?" {
+		t.Errorf("unexpected lines %q", lines)
+	}
+}
+
+func TestTotalFitEmergency(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	par, lines := totalFit(t, "A Donaudampfschifffahrtsgesellschaft is long", 12, nil)
+	if strings.Join(lines, "|") != "A|Donaudampfschifffahrtsgesellschaft|is long" {
+		t.Errorf("unexpected lines %q", lines)
+	}
+	if r := par.Breakpoints[1].Ratio; !math.IsInf(r, -1) && r >= -1 {
+		t.Errorf("expected second line to be overfull, has ratio %.2f", r)
+	}
+}
+
+func TestTotalFitSuppressedBreaks(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	// A boundary predicate lets the segmenter deliver segments between all the
+	// code-points, but suppressed breaks must not become break opportunities.
+	_, expected := totalFit(t, knuthPlassText, 40, nil)
+	for _, seg := range []*segment.Segmenter{
+		segment.NewSegmenter(uax14.NewLineWrap()),
+		segment.NewSegmenter(uax14.NewLineWrap(), uax14.NewLineWrap()),
+	} {
+		seg.SetBoundaryPredicate(func([]int) bool { return true })
+		seg.InitFromString(knuthPlassText)
+		par, err := wrap.TotalFit(seg, monospace{}, 40, nil)
+		if err != nil {
+			t.Fatalf("total-fit failed: %v", err)
+		}
+		if len(par.Breakpoints) != len(expected) {
+			t.Errorf("expected %d lines, have %d", len(expected), len(par.Breakpoints))
+		}
+	}
+	// With every line overfull, the second pass has to skip the suppressed
+	// breaks to find the next break opportunity.
+	seg := segment.NewSegmenter(uax14.NewLineWrap(), uax14.NewLineWrap())
+	seg.SetBoundaryPredicate(func([]int) bool { return true })
+	seg.InitFromString("Hello World")
+	par, err := wrap.TotalFit(seg, monospace{}, 1, nil)
+	if err != nil {
+		t.Fatalf("total-fit failed: %v", err)
+	}
+	if len(par.Breakpoints) != 2 || par.Breakpoints[0].Position != 6 {
+		t.Errorf("expected lines 'Hello' and 'World', have breakpoints %v", par.Breakpoints)
+	}
+}
+
+func TestTotalFitSoftHyphen(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	par, lines := totalFit(t, "This is extra­ordinary indeed", 15, nil)
+	if strings.Join(lines, "|") != "This is extra­-|ordinary indeed" {
+		t.Errorf("unexpected lines %q", lines)
+	}
+	if !par.Breakpoints[0].Hyphenated {
+		t.Errorf("expected first line to be hyphenated")
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Package wrap fills lines of text up to a maximum width.
+
+Package uax14 finds the opportunities for line breaks in a text, but does not
+decide which of them to use. Package wrap implements the most common way to do
+this: a greedy algorithm, filling each line with as much text as fits and
+then breaking at the last break opportunity found.
+
+    lines := wrap.Text("Hello World, how are you?", 12, nil)
+    for _, line := range lines {
+        fmt.Println(line)
+    }
+
+The width of text is determined by a measure function. The default measure
+function uses UAX#11 East Asian Width (see package uax11), which is suitable
+for text to be displayed on terminals: widths are counted in `en`s, i.e. in
+terminal columns, with East Asian wide characters taking two columns.
+
+Mandatory breaks, as found by UAX#14, are always honoured. Words which are too
+wide to fit on a line by themselves are broken at grapheme boundaries.
+
+_______________________________________________________________________
+
+License
+
+This project is provided under the terms of the UNLICENSE or
+the 3-Clause BSD license denoted by the following SPDX identifier:
+
+SPDX-License-Identifier: 'Unlicense' OR 'BSD-3-Clause'
+
+You may use the project under the terms of either license.
+
+Licenses are reproduced in the license file in the root folder of this module.
+
+Copyright © 2021 Norbert Pillmayer <norbert@pillmayer.com>
+
+*/
+package wrap
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/uax"
+	"github.com/npillmayer/uax/grapheme"
+	"github.com/npillmayer/uax/segment"
+	"github.com/npillmayer/uax/uax11"
+	"github.com/npillmayer/uax/uax14"
+)
+
+// tracer traces to uax.segment .
+func tracer() tracing.Trace {
+	return tracing.Select("uax.segment")
+}
+
+// MeasureFunc returns the width of a fragment of text. Widths may be given in
+// any unit, as long as the maximum width for lines uses the same unit.
+type MeasureFunc func(text string) int
+
+// UAX11Measure returns a measure function which sums up the UAX#11 widths of
+// the grapheme clusters of a text (see uax11.Width). Widths are counted in `en`s,
+// i.e. a narrow character has width 1 and a wide character has width 2.
+//
+// If context is nil, uax11.LatinContext is used.
+func UAX11Measure(context *uax11.Context) MeasureFunc {
+	if context == nil {
+		context = uax11.LatinContext
+	}
+	return func(text string) int {
+		w := 0
+		for g := range grapheme.All(text) {
+			w += uax11.Width([]byte(g), context)
+		}
+		return w
+	}
+}
+
+// Text wraps a text into lines of a maximum width. If measure is nil, UAX11Measure(nil)
+// is used.
+//
+// Lines are broken at UAX#14 line break opportunities. Mandatory breaks, i.e. break
+// opportunities with a penalty of uax.InfiniteMerits or less, are always
+// honoured. If a word does not fit on a line by itself, it is broken at
+// grapheme boundaries.
+//
+// Whitespace at the end of a line (including the characters of a mandatory break)
+// is not taken into account for measuring the line, and is removed from the lines returned.
+func Text(text string, maxWidth int, measure MeasureFunc) []string {
+	if measure == nil {
+		measure = UAX11Measure(nil)
+	}
+	w := &wrapper{text: text, maxWidth: maxWidth, measure: measure}
+	seg := segment.NewSegmenter(uax14.NewLineWrap())
+	seg.InitFromString(text)
+	for seg.Next() {
+		start, end := seg.ByteOffsets()
+		p0, _ := seg.Penalties()
+		w.fragment(start, end, p0 <= uax.InfiniteMerits || endsWithHardBreak(seg.Text()))
+	}
+	if _, end := seg.ByteOffsets(); end < len(text) { // no break at the end of text
+		w.fragment(end, len(text), false)
+	}
+	if w.lineStart < w.lineEnd {
+		w.emit()
+	}
+	return w.lines
+}
+
+// wrapper holds the state of the greedy line filling.
+type wrapper struct {
+	text      string
+	maxWidth  int
+	measure   MeasureFunc
+	lineStart int // start of the current line within text
+	lineEnd   int // end of the current line within text
+	lines     []string
+}
+
+// fragment adds the text between two break opportunities to the current line.
+func (w *wrapper) fragment(start, end int, mustBreak bool) {
+	if w.lineStart < w.lineEnd && w.width(w.lineStart, end) > w.maxWidth {
+		w.emit() // fragment does not fit onto the current line
+	}
+	if w.lineStart == w.lineEnd {
+		w.lineStart = start
+		for w.width(w.lineStart, end) > w.maxWidth {
+			// overlong word: break it at grapheme boundaries
+			if !w.breakOverlong(end) {
+				break
+			}
+		}
+	}
+	w.lineEnd = end
+	if mustBreak {
+		w.emit()
+	}
+}
+
+// breakOverlong emits the longest run of grapheme clusters from the start of the
+// current line which fits into the maximum width. At least one grapheme cluster is
+// emitted. Returns false if nothing could be emitted.
+func (w *wrapper) breakOverlong(end int) bool {
+	pos := w.lineStart
+	for g := range grapheme.All(w.text[w.lineStart:end]) {
+		if pos > w.lineStart && w.width(w.lineStart, pos+len(g)) > w.maxWidth {
+			break
+		}
+		pos += len(g)
+	}
+	if pos == w.lineStart || pos == end {
+		return false
+	}
+	tracer().Debugf("wrap: breaking overlong word at position %d", pos)
+	w.lineEnd = pos
+	w.emit()
+	w.lineStart = pos
+	return true
+}
+
+// emit outputs the current line and starts a new one.
+func (w *wrapper) emit() {
+	w.lines = append(w.lines, strings.TrimRightFunc(w.text[w.lineStart:w.lineEnd], unicode.IsSpace))
+	w.lineStart = w.lineEnd
+}
+
+// endsWithHardBreak checks if a fragment ends with a line break character (rule LB4
+// and LB5). Penalties of rules overlapping at runs of line breaks add up, so we do not
+// rely on the penalty alone to find mandatory breaks.
+func endsWithHardBreak(fragment string) bool {
+	r, _ := utf8.DecodeLastRuneInString(fragment)
+	switch uax14.ClassForRune(r) {
+	case uax14.BKClass, uax14.CRClass, uax14.LFClass, uax14.NLClass:
+		return true
+	}
+	return false
+}
+
+// width measures a part of the text, ignoring trailing whitespace.
+func (w *wrapper) width(start, end int) int {
+	return w.measure(strings.TrimRightFunc(w.text[start:end], unicode.IsSpace))
+}
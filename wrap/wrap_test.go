@@ -0,0 +1,66 @@
+package wrap_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/npillmayer/schuko/tracing"
+	"github.com/npillmayer/schuko/tracing/gotestingadapter"
+	"github.com/npillmayer/uax/uax11"
+	"github.com/npillmayer/uax/wrap"
+)
+
+func ExampleText() {
+	lines := wrap.Text("Hello World, how are you?", 12, nil)
+	for _, line := range lines {
+		fmt.Printf("|%-12s|\n", line)
+	}
+	// Output: |Hello World,|
+	// |how are you?|
+}
+
+func TestWrap(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	runes := func(s string) int { return utf8.RuneCountInString(s) }
+	for i, test := range []struct {
+		text     string
+		width    int
+		expected string
+	}{
+		{"The quick brown fox jumps over the lazy dog.", 10, "The quick|brown fox|jumps over|the lazy|dog."},
+		{"The quick brown fox jumps over the lazy dog.", 80, "The quick brown fox jumps over the lazy dog."},
+		{"Hello\nWorld", 80, "Hello|World"},
+		{"Hello\n\nWorld\n", 80, "Hello||World"},
+		{"A Donaudampfschifffahrtsgesellschaft ist gut", 10, "A|Donaudampf|schifffahr|tsgesellsc|haft ist|gut"},
+		{"well-known ad-hoc solutions", 8, "well-|known|ad-hoc|solution|s"},
+		{"x", 0, "x"},
+		{"", 10, ""},
+	} {
+		lines := wrap.Text(test.text, test.width, runes)
+		if strings.Join(lines, "|") != test.expected {
+			t.Errorf("test #%d: expected %q, have %q", i, test.expected, strings.Join(lines, "|"))
+		}
+	}
+}
+
+func TestWrapEastAsian(t *testing.T) {
+	teardown := gotestingadapter.QuickConfig(t, "uax.segment")
+	defer teardown()
+	tracing.Select("uax.segment").SetTraceLevel(tracing.LevelError)
+	//
+	measure := wrap.UAX11Measure(uax11.EastAsianContext)
+	lines := wrap.Text("日本語のテキストを折り返す", 10, measure)
+	if strings.Join(lines, "|") != "日本語のテ|キストを折|り返す" {
+		t.Errorf("unexpected lines %q", lines)
+	}
+	for _, line := range lines {
+		if w := measure(line); w > 10 {
+			t.Errorf("line %q is too wide: %d", line, w)
+		}
+	}
+}